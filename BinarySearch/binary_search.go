@@ -22,3 +22,35 @@ func BinarySearch[T constraints.Ordered](a []T, key T) (int, bool) {
 	}
 	return -1, false
 }
+
+// Returns the index of the first element in the sorted array that is not less than key.
+// If every element is less than key, len(a) is returned.
+func LowerBound[T constraints.Ordered](a []T, key T) int {
+	var lowerBound = 0
+	var upperBound = len(a)
+	for lowerBound < upperBound {
+		var midIndex = lowerBound + (upperBound-lowerBound)/2
+		if a[midIndex] < key {
+			lowerBound = midIndex + 1
+		} else {
+			upperBound = midIndex
+		}
+	}
+	return lowerBound
+}
+
+// Returns the index of the first element in the sorted array that is greater than key.
+// If no element is greater than key, len(a) is returned.
+func UpperBound[T constraints.Ordered](a []T, key T) int {
+	var lowerBound = 0
+	var upperBound = len(a)
+	for lowerBound < upperBound {
+		var midIndex = lowerBound + (upperBound-lowerBound)/2
+		if a[midIndex] <= key {
+			lowerBound = midIndex + 1
+		} else {
+			upperBound = midIndex
+		}
+	}
+	return lowerBound
+}
@@ -68,3 +68,15 @@ func TestOutOfUpperBound(t *testing.T) {
 	_, valid := BinarySearch(nums, 501)
 	AssertFalse(valid, t)
 }
+
+func TestLowerAndUpperBoundFunctions(t *testing.T) {
+	nums := []int{1, 3, 3, 3, 5, 8}
+	AssertEqual(LowerBound(nums, 0), 0, t)
+	AssertEqual(LowerBound(nums, 3), 1, t)
+	AssertEqual(UpperBound(nums, 3), 4, t)
+	AssertEqual(LowerBound(nums, 4), 4, t)
+	AssertEqual(UpperBound(nums, 4), 4, t)
+	AssertEqual(LowerBound(nums, 9), 6, t)
+	AssertEqual(UpperBound(nums, 8), 6, t)
+	AssertEqual(LowerBound([]int{}, 1), 0, t)
+}
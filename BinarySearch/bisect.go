@@ -0,0 +1,148 @@
+package search
+
+import (
+	"errors"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+	"golang.org/x/exp/constraints"
+)
+
+// DefaultMaxIterations is the iteration limit used by Bisect and SearchAnswer.
+// It is large enough to walk a float64 interval down to adjacent representable values.
+const DefaultMaxIterations = 1 << 12
+
+var (
+	// ErrInvalidBracket is returned when lo > hi, when a bound is NaN or infinite, or when the
+	// bracket cannot contain an answer (f(lo) and f(hi) share a sign, or pred(hi) is false).
+	ErrInvalidBracket = errors.New("search: invalid bracket")
+	// ErrInvalidTolerance is returned when the tolerance is negative or NaN.
+	ErrInvalidTolerance = errors.New("search: invalid tolerance")
+	// ErrNaN is returned when the function being bisected evaluates to NaN.
+	ErrNaN = errors.New("search: function returned NaN")
+	// ErrMaxIterations is returned alongside the best estimate found when the iteration
+	// limit is reached before the tolerance is met.
+	ErrMaxIterations = errors.New("search: iteration limit reached")
+)
+
+// Bisect finds a root of the continuous function f in the interval [lo, hi].
+// f(lo) and f(hi) must have opposite signs (or one of them must be zero).
+// The search stops once the bracket is no wider than tol, or once the bracket can't be
+// split any further, and returns the midpoint of the final bracket.
+func Bisect[F constraints.Float](f func(F) F, lo, hi, tol F) (F, error) {
+	return BisectN(f, lo, hi, tol, DefaultMaxIterations)
+}
+
+// BisectN is Bisect with an explicit iteration limit.
+func BisectN[F constraints.Float](f func(F) F, lo, hi, tol F, maxIterations int) (F, error) {
+	if !isFinite(lo) || !isFinite(hi) || lo > hi {
+		return lo, ErrInvalidBracket
+	}
+	if isNaN(tol) || tol < 0 {
+		return lo, ErrInvalidTolerance
+	}
+
+	fLo, fHi := f(lo), f(hi)
+	if isNaN(fLo) || isNaN(fHi) {
+		return lo, ErrNaN
+	}
+	if fLo == 0 {
+		return lo, nil
+	}
+	if fHi == 0 {
+		return hi, nil
+	}
+	if (fLo < 0) == (fHi < 0) {
+		return lo, ErrInvalidBracket
+	}
+
+	for i := 0; i < maxIterations; i++ {
+		mid, ok := midpoint(lo, hi, tol)
+		if !ok {
+			return lo + (hi-lo)/2, nil
+		}
+		fMid := f(mid)
+		if isNaN(fMid) {
+			return mid, ErrNaN
+		}
+		if fMid == 0 {
+			return mid, nil
+		}
+		if (fMid < 0) == (fLo < 0) {
+			lo, fLo = mid, fMid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2, ErrMaxIterations
+}
+
+// SearchAnswer performs a "binary search on the answer". pred must be monotone on [lo, hi]:
+// false for every value below some threshold and true from the threshold onwards.
+// It returns the smallest value in [lo, hi] for which pred is true, to within tol.
+// For integer types a tol of 0 gives the exact answer; for floats it narrows the bracket
+// until no representable value remains between the bounds.
+func SearchAnswer[N Numeric](lo, hi, tol N, pred Predicate[N]) (N, error) {
+	return SearchAnswerN(lo, hi, tol, pred, DefaultMaxIterations)
+}
+
+// SearchAnswerN is SearchAnswer with an explicit iteration limit.
+func SearchAnswerN[N Numeric](lo, hi, tol N, pred Predicate[N], maxIterations int) (N, error) {
+	if !isFinite(lo) || !isFinite(hi) || lo > hi {
+		return lo, ErrInvalidBracket
+	}
+	if isNaN(tol) || tol < 0 {
+		return lo, ErrInvalidTolerance
+	}
+	if pred(lo) {
+		return lo, nil
+	}
+	if !pred(hi) {
+		return hi, ErrInvalidBracket
+	}
+
+	// Invariant: pred(lo) is false and pred(hi) is true.
+	for i := 0; i < maxIterations; i++ {
+		mid, ok := midpoint(lo, hi, tol)
+		if !ok {
+			return hi, nil
+		}
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, ErrMaxIterations
+}
+
+func isNaN[N Numeric](n N) bool {
+	return n != n
+}
+
+// isFinite reports whether n is neither NaN nor infinite; integers always are.
+func isFinite[N Numeric](n N) bool {
+	return n-n == 0
+}
+
+// midpoint returns a value strictly between lo and hi, or false when the bracket is already
+// within tol or no such value exists.
+func midpoint[N Numeric](lo, hi, tol N) (N, bool) {
+	width := hi - lo
+	// width overflows for signed integers spanning more than half the range,
+	// and becomes +Inf for floats spanning more than the largest float.
+	overflow := width < 0 || width-width != 0
+	if !overflow && width <= tol {
+		return lo, false
+	}
+
+	var mid N
+	if overflow {
+		mid = lo/2 + hi/2
+	} else {
+		mid = lo + width/2
+	}
+	if mid <= lo || mid >= hi {
+		return lo, false
+	}
+	return mid, true
+}
@@ -0,0 +1,180 @@
+package search
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestBisectSquareRoot(t *testing.T) {
+	f := func(x float64) float64 { return x*x - 2 }
+	root, err := Bisect(f, 0, 2, 1e-12)
+	AssertTrue(err == nil, t)
+	AssertTrue(math.Abs(root-math.Sqrt2) <= 1e-12, t)
+}
+
+func TestBisectExactTolerance(t *testing.T) {
+	f := func(x float64) float64 { return math.Cos(x) }
+	root, err := Bisect(f, 0, 3, 0)
+	AssertTrue(err == nil, t)
+	AssertTrue(math.Abs(root-math.Pi/2) <= 1e-15, t)
+}
+
+func TestBisectRootAtBound(t *testing.T) {
+	f := func(x float64) float64 { return x - 1 }
+	root, err := Bisect(f, 1, 5, 1e-9)
+	AssertTrue(err == nil, t)
+	AssertEqual(root, 1.0, t)
+
+	root, err = Bisect(f, -3, 1, 1e-9)
+	AssertTrue(err == nil, t)
+	AssertEqual(root, 1.0, t)
+}
+
+func TestBisectDecreasingFunction(t *testing.T) {
+	f := func(x float32) float32 { return 10 - x*x*x }
+	root, err := Bisect(f, 0, 10, 1e-4)
+	AssertTrue(err == nil, t)
+	AssertTrue(math.Abs(float64(root)-math.Cbrt(10)) <= 1e-4, t)
+}
+
+func TestBisectInvalidBracket(t *testing.T) {
+	f := func(x float64) float64 { return x*x + 1 }
+	_, err := Bisect(f, -1, 1, 1e-9)
+	AssertTrue(err == ErrInvalidBracket, t)
+
+	g := func(x float64) float64 { return x }
+	_, err = Bisect(g, 1, -1, 1e-9)
+	AssertTrue(err == ErrInvalidBracket, t)
+	_, err = Bisect(g, math.NaN(), 1, 1e-9)
+	AssertTrue(err == ErrInvalidBracket, t)
+	_, err = Bisect(g, -1, 1, -1)
+	AssertTrue(err == ErrInvalidTolerance, t)
+}
+
+func TestBisectInfiniteBounds(t *testing.T) {
+	f := func(x float64) float64 { return x }
+	testCases := []struct {
+		lo float64
+		hi float64
+	}{
+		{lo: math.Inf(-1), hi: 1},
+		{lo: -1, hi: math.Inf(1)},
+		{lo: math.Inf(-1), hi: math.Inf(1)},
+		{lo: math.Inf(1), hi: math.Inf(1)},
+		{lo: math.Inf(-1), hi: math.Inf(-1)},
+	}
+	for _, testCase := range testCases {
+		_, err := Bisect(f, testCase.lo, testCase.hi, 0)
+		if err != ErrInvalidBracket {
+			t.Fatalf("lo %v hi %v: expected ErrInvalidBracket got %v", testCase.lo, testCase.hi, err)
+		}
+	}
+}
+
+func TestBisectNaN(t *testing.T) {
+	f := func(x float64) float64 { return math.Log(x) }
+	_, err := Bisect(f, -1, 2, 1e-9)
+	AssertTrue(err == ErrNaN, t)
+
+	g := func(x float64) float64 {
+		if x > -0.5 && x < 0.5 {
+			return math.NaN()
+		}
+		return x
+	}
+	_, err = Bisect(g, -1, 2, 1e-9)
+	AssertTrue(err == ErrNaN, t)
+}
+
+func TestBisectIterationLimit(t *testing.T) {
+	f := func(x float64) float64 { return x - math.Pi }
+	root, err := BisectN(f, 0, 4, 0, 10)
+	AssertTrue(err == ErrMaxIterations, t)
+	AssertTrue(math.Abs(root-math.Pi) <= 4.0/1024, t)
+}
+
+func TestSearchAnswerIntegers(t *testing.T) {
+	// Smallest n such that n*n >= 1000.
+	answer, err := SearchAnswer(0, 1000, 0, func(n int) bool { return n*n >= 1000 })
+	AssertTrue(err == nil, t)
+	AssertEqual(answer, 32, t)
+
+	for threshold := -10; threshold <= 10; threshold++ {
+		answer, err := SearchAnswer(-10, 10, 0, func(n int) bool { return n >= threshold })
+		AssertTrue(err == nil, t)
+		AssertEqual(answer, threshold, t)
+	}
+}
+
+func TestSearchAnswerIntegerExtremes(t *testing.T) {
+	answer, err := SearchAnswer(math.MinInt64, math.MaxInt64, 0, func(n int64) bool { return n >= 12345 })
+	AssertTrue(err == nil, t)
+	AssertEqual(answer, int64(12345), t)
+
+	unsigned, err := SearchAnswer(0, math.MaxUint64, 0, func(n uint64) bool { return n >= 1<<63 })
+	AssertTrue(err == nil, t)
+	AssertEqual(unsigned, uint64(1<<63), t)
+}
+
+func TestSearchAnswerIntegerTolerance(t *testing.T) {
+	answer, err := SearchAnswer(0, 1024, 8, func(n int) bool { return n >= 500 })
+	AssertTrue(err == nil, t)
+	AssertTrue(answer >= 500 && answer-500 <= 8, t)
+}
+
+func TestSearchAnswerFloats(t *testing.T) {
+	answer, err := SearchAnswer(0, 10, 1e-9, func(x float64) bool { return x*x >= 2 })
+	AssertTrue(err == nil, t)
+	AssertTrue(answer >= math.Sqrt2 && answer-math.Sqrt2 <= 1e-9, t)
+
+	exact, err := SearchAnswer(0, 10, 0, func(x float64) bool { return x >= 0.1 })
+	AssertTrue(err == nil, t)
+	AssertEqual(exact, 0.1, t)
+
+	huge, err := SearchAnswer(-math.MaxFloat64, math.MaxFloat64, 0, func(x float64) bool { return x >= 1 })
+	AssertTrue(err == nil, t)
+	AssertEqual(huge, 1.0, t)
+}
+
+func TestSearchAnswerBounds(t *testing.T) {
+	answer, err := SearchAnswer(5, 10, 0, func(n int) bool { return true })
+	AssertTrue(err == nil, t)
+	AssertEqual(answer, 5, t)
+
+	_, err = SearchAnswer(5, 10, 0, func(n int) bool { return false })
+	AssertTrue(err == ErrInvalidBracket, t)
+	_, err = SearchAnswer(10, 5, 0, func(n int) bool { return true })
+	AssertTrue(err == ErrInvalidBracket, t)
+	_, err = SearchAnswer(math.NaN(), 1, 0, func(x float64) bool { return true })
+	AssertTrue(err == ErrInvalidBracket, t)
+	_, err = SearchAnswer(0, 1, math.NaN(), func(x float64) bool { return true })
+	AssertTrue(err == ErrInvalidTolerance, t)
+}
+
+func TestSearchAnswerInfiniteBounds(t *testing.T) {
+	pred := func(x float64) bool { return x >= 1 }
+	testCases := []struct {
+		lo float64
+		hi float64
+	}{
+		{lo: math.Inf(-1), hi: 10},
+		{lo: 0, hi: math.Inf(1)},
+		{lo: math.Inf(-1), hi: math.Inf(1)},
+		{lo: math.Inf(1), hi: math.Inf(1)},
+		{lo: math.Inf(-1), hi: math.Inf(-1)},
+	}
+	for _, testCase := range testCases {
+		_, err := SearchAnswer(testCase.lo, testCase.hi, 0, pred)
+		if err != ErrInvalidBracket {
+			t.Fatalf("lo %v hi %v: expected ErrInvalidBracket got %v", testCase.lo, testCase.hi, err)
+		}
+	}
+}
+
+func TestSearchAnswerIterationLimit(t *testing.T) {
+	answer, err := SearchAnswerN(0, 1<<20, 0, func(n int) bool { return n >= 777 }, 5)
+	AssertTrue(err == ErrMaxIterations, t)
+	AssertTrue(answer >= 777, t)
+}
@@ -0,0 +1,207 @@
+package search
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+// ErrInvalidRecordSize is returned by RecordSearcherInit when the record size is not positive.
+var ErrInvalidRecordSize = errors.New("search: record size must be positive")
+
+// chunkSize is how many bytes are read at a time while scanning for line boundaries.
+const chunkSize = 4096
+
+// Match is a record found in a file, along with the byte offset at which it starts.
+type Match struct {
+	Offset int64
+	Record []byte
+}
+
+// PrefixCompare orders a record against a key by comparing only the first len(key) bytes
+// of the record. Every record that starts with key compares equal to it, which gives the
+// behaviour of the Unix look command.
+func PrefixCompare(record, key []byte) int {
+	if len(record) > len(key) {
+		record = record[:len(key)]
+	}
+	return bytes.Compare(record, key)
+}
+
+// FileSearcher performs binary searches directly on sorted data behind an io.ReaderAt,
+// without loading it into memory. The data is either newline-delimited text or a sequence
+// of fixed-width records, and must be sorted according to the searcher's compare function.
+type FileSearcher struct {
+	reader     io.ReaderAt
+	size       int64                        // The number of bytes that make up the data.
+	recordSize int                          // The width of a record, or 0 for newline-delimited text.
+	compare    func(record, key []byte) int // Orders a record against the search key.
+}
+
+// LineSearcherInit returns a searcher over newline-delimited text. Records are the lines
+// without their trailing newline. If compare is nil, PrefixCompare is used.
+func LineSearcherInit(reader io.ReaderAt, size int64, compare func(record, key []byte) int) *FileSearcher {
+	if compare == nil {
+		compare = PrefixCompare
+	}
+	return &FileSearcher{reader: reader, size: size, compare: compare}
+}
+
+// RecordSearcherInit returns a searcher over fixed-width records of recordSize bytes.
+// A trailing partial record is ignored. If compare is nil, PrefixCompare is used.
+func RecordSearcherInit(reader io.ReaderAt, size int64, recordSize int, compare func(record, key []byte) int) (*FileSearcher, error) {
+	if recordSize <= 0 {
+		return nil, ErrInvalidRecordSize
+	}
+	if compare == nil {
+		compare = PrefixCompare
+	}
+	return &FileSearcher{reader: reader, size: size, recordSize: recordSize, compare: compare}, nil
+}
+
+// BinarySearch looks for a record that compares equal to key. If there is more than one,
+// there is no guarantee which one it finds. The boolean reports whether a match was found.
+func (self *FileSearcher) BinarySearch(key []byte) (Match, bool, error) {
+	lo, hi := int64(0), self.end()
+	for lo < hi {
+		record, start, end, err := self.recordAround(lo + (hi-lo)/2)
+		if err != nil {
+			return Match{}, false, err
+		}
+		switch c := self.compare(record, key); {
+		case c == 0:
+			return Match{Offset: start, Record: record}, true, nil
+		case c < 0:
+			lo = end
+		default:
+			hi = start
+		}
+	}
+	return Match{}, false, nil
+}
+
+// LowerBound returns the offset of the first record that does not compare less than key.
+// If every record is less than key, the offset just past the last record is returned.
+func (self *FileSearcher) LowerBound(key []byte) (int64, error) {
+	// Invariant: every record starting before lo is less than key,
+	// and every record starting at or after hi is not.
+	lo, hi := int64(0), self.end()
+	for lo < hi {
+		record, start, end, err := self.recordAround(lo + (hi-lo)/2)
+		if err != nil {
+			return 0, err
+		}
+		if self.compare(record, key) < 0 {
+			lo = end
+		} else {
+			hi = start
+		}
+	}
+	return lo, nil
+}
+
+// Look returns every record that compares equal to key, in file order.
+func (self *FileSearcher) Look(key []byte) ([]Match, error) {
+	offset, err := self.LowerBound(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Match
+	for offset < self.end() {
+		record, end, err := self.recordAt(offset)
+		if err != nil {
+			return matches, err
+		}
+		if self.compare(record, key) != 0 {
+			break
+		}
+		matches = append(matches, Match{Offset: offset, Record: record})
+		offset = end
+	}
+	return matches, nil
+}
+
+// end returns the offset just past the last complete record.
+func (self *FileSearcher) end() int64 {
+	if self.recordSize == 0 {
+		return self.size
+	}
+	return self.size - self.size%int64(self.recordSize)
+}
+
+// recordAround returns the record containing the byte at offset, along with the offsets
+// at which it starts and ends (including a trailing newline for text).
+func (self *FileSearcher) recordAround(offset int64) ([]byte, int64, int64, error) {
+	var start int64
+	if self.recordSize > 0 {
+		start = offset - offset%int64(self.recordSize)
+	} else {
+		var err error
+		if start, err = self.lineStart(offset); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	record, end, err := self.recordAt(start)
+	return record, start, end, err
+}
+
+// recordAt reads the record starting at offset and returns it along with the offset of
+// the next record.
+func (self *FileSearcher) recordAt(offset int64) ([]byte, int64, error) {
+	if self.recordSize > 0 {
+		record := make([]byte, self.recordSize)
+		if err := self.readAt(record, offset); err != nil {
+			return nil, 0, err
+		}
+		return record, offset + int64(self.recordSize), nil
+	}
+
+	var line []byte
+	buffer := make([]byte, chunkSize)
+	for position := offset; position < self.size; {
+		chunk := buffer[:Min[int64](chunkSize, self.size-position)]
+		if err := self.readAt(chunk, position); err != nil {
+			return nil, 0, err
+		}
+		if index := bytes.IndexByte(chunk, '\n'); index >= 0 {
+			line = append(line, chunk[:index]...)
+			return line, position + int64(index) + 1, nil
+		}
+		line = append(line, chunk...)
+		position += int64(len(chunk))
+	}
+	return line, self.size, nil
+}
+
+// lineStart returns the offset of the first byte of the line containing offset.
+func (self *FileSearcher) lineStart(offset int64) (int64, error) {
+	buffer := make([]byte, chunkSize)
+	for end := offset; end > 0; {
+		begin := end - Min[int64](chunkSize, end)
+		chunk := buffer[:end-begin]
+		if err := self.readAt(chunk, begin); err != nil {
+			return 0, err
+		}
+		if index := bytes.LastIndexByte(chunk, '\n'); index >= 0 {
+			return begin + int64(index) + 1, nil
+		}
+		end = begin
+	}
+	return 0, nil
+}
+
+// readAt fills buffer from offset, tolerating the io.EOF some readers return alongside
+// a complete read at the end of the data.
+func (self *FileSearcher) readAt(buffer []byte, offset int64) error {
+	n, err := self.reader.ReadAt(buffer, offset)
+	if n == len(buffer) {
+		return nil
+	}
+	if err == nil || err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
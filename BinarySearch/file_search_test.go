@@ -0,0 +1,190 @@
+package search
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+var words = []string{"apple", "apricot", "banana", "blueberry", "blueberry", "cherry", "date", "fig", "grape"}
+
+func lineSearcher(lines []string) (*FileSearcher, string) {
+	text := strings.Join(lines, "\n") + "\n"
+	return LineSearcherInit(strings.NewReader(text), int64(len(text)), nil), text
+}
+
+func TestLineSearchFindsEveryLine(t *testing.T) {
+	searcher, text := lineSearcher(words)
+	for _, word := range words {
+		match, valid, err := searcher.BinarySearch([]byte(word))
+		AssertTrue(err == nil, t)
+		AssertTrue(valid, t)
+		AssertEqual(string(match.Record), word, t)
+		AssertEqual(text[match.Offset:match.Offset+int64(len(word))], word, t)
+	}
+}
+
+func TestLineSearchMissingKey(t *testing.T) {
+	searcher, _ := lineSearcher(words)
+	for _, key := range []string{"aardvark", "avocado", "kiwi", "c"} {
+		_, valid, err := searcher.BinarySearch([]byte(key + "!"))
+		AssertTrue(err == nil, t)
+		AssertFalse(valid, t)
+	}
+}
+
+func TestLineSearchEmpty(t *testing.T) {
+	searcher := LineSearcherInit(strings.NewReader(""), 0, nil)
+	_, valid, err := searcher.BinarySearch([]byte("a"))
+	AssertTrue(err == nil, t)
+	AssertFalse(valid, t)
+	matches, err := searcher.Look([]byte("a"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 0, t)
+}
+
+func TestLook(t *testing.T) {
+	searcher, text := lineSearcher(words)
+
+	matches, err := searcher.Look([]byte("ap"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 2, t)
+	AssertEqual(string(matches[0].Record), "apple", t)
+	AssertEqual(string(matches[1].Record), "apricot", t)
+	AssertEqual(matches[0].Offset, int64(0), t)
+	AssertEqual(matches[1].Offset, int64(strings.Index(text, "apricot")), t)
+
+	matches, err = searcher.Look([]byte("blueberry"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 2, t)
+
+	matches, err = searcher.Look([]byte("b"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 3, t)
+
+	matches, err = searcher.Look([]byte("zucchini"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 0, t)
+}
+
+func TestLineLowerBound(t *testing.T) {
+	searcher, text := lineSearcher(words)
+	offset, err := searcher.LowerBound([]byte("c"))
+	AssertTrue(err == nil, t)
+	AssertEqual(offset, int64(strings.Index(text, "cherry")), t)
+
+	offset, err = searcher.LowerBound([]byte("zzz"))
+	AssertTrue(err == nil, t)
+	AssertEqual(offset, int64(len(text)), t)
+}
+
+func TestLineSearchLongLinesWithoutTrailingNewline(t *testing.T) {
+	// Lines longer than the read chunk force multi-chunk scans in both directions.
+	lines := []string{}
+	for i := 0; i < 50; i++ {
+		lines = append(lines, fmt.Sprintf("%03d%s", i, strings.Repeat("x", 3*chunkSize/2)))
+	}
+	text := strings.Join(lines, "\n")
+	searcher := LineSearcherInit(strings.NewReader(text), int64(len(text)), nil)
+	for i, line := range lines {
+		match, valid, err := searcher.BinarySearch([]byte(fmt.Sprintf("%03d", i)))
+		AssertTrue(err == nil, t)
+		AssertTrue(valid, t)
+		AssertTrue(string(match.Record) == line, t)
+	}
+}
+
+func TestLineSearchOnDisk(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	lines := []string{}
+	for i := 0; i < 10000; i++ {
+		lines = append(lines, fmt.Sprintf("key%06d value%d", i*2, i))
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	info, _ := file.Stat()
+
+	searcher := LineSearcherInit(file, info.Size(), nil)
+	match, valid, err := searcher.BinarySearch([]byte("key012346 "))
+	AssertTrue(err == nil, t)
+	AssertTrue(valid, t)
+	AssertEqual(string(match.Record), "key012346 value6173", t)
+
+	_, valid, err = searcher.BinarySearch([]byte("key012347 "))
+	AssertTrue(err == nil, t)
+	AssertFalse(valid, t)
+}
+
+func TestRecordSearch(t *testing.T) {
+	var data bytes.Buffer
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&data, "%08d|%-7d", i*3, i)
+	}
+	data.WriteString("partial")
+	searcher, err := RecordSearcherInit(bytes.NewReader(data.Bytes()), int64(data.Len()), 16, nil)
+	AssertTrue(err == nil, t)
+
+	match, valid, err := searcher.BinarySearch([]byte("00000333"))
+	AssertTrue(err == nil, t)
+	AssertTrue(valid, t)
+	AssertEqual(match.Offset, int64(111*16), t)
+	AssertEqual(string(match.Record), "00000333|111    ", t)
+
+	_, valid, err = searcher.BinarySearch([]byte("00000334"))
+	AssertTrue(err == nil, t)
+	AssertFalse(valid, t)
+
+	matches, err := searcher.Look([]byte("0000299"))
+	AssertTrue(err == nil, t)
+	AssertEqual(len(matches), 3, t)
+	AssertEqual(string(matches[0].Record[:8]), "00002991", t)
+
+	offset, err := searcher.LowerBound([]byte("99999999"))
+	AssertTrue(err == nil, t)
+	AssertEqual(offset, int64(1000*16), t)
+}
+
+func TestRecordSearchCustomCompare(t *testing.T) {
+	// Records are sorted by their second byte only.
+	data := []byte("za" + "yb" + "xc" + "wd")
+	compare := func(record, key []byte) int { return bytes.Compare(record[1:2], key) }
+	searcher, err := RecordSearcherInit(bytes.NewReader(data), int64(len(data)), 2, compare)
+	AssertTrue(err == nil, t)
+	match, valid, err := searcher.BinarySearch([]byte("c"))
+	AssertTrue(err == nil, t)
+	AssertTrue(valid, t)
+	AssertEqual(string(match.Record), "xc", t)
+}
+
+func TestInvalidRecordSize(t *testing.T) {
+	_, err := RecordSearcherInit(strings.NewReader(""), 0, 0, nil)
+	AssertTrue(err == ErrInvalidRecordSize, t)
+}
+
+type failingReader struct{}
+
+var errRead = errors.New("read failed")
+
+func (failingReader) ReadAt(p []byte, off int64) (int, error) {
+	return 0, errRead
+}
+
+func TestReadErrorIsReturned(t *testing.T) {
+	searcher := LineSearcherInit(failingReader{}, 100, nil)
+	_, _, err := searcher.BinarySearch([]byte("a"))
+	AssertTrue(err == errRead, t)
+	_, err = searcher.Look([]byte("a"))
+	AssertTrue(err == errRead, t)
+}
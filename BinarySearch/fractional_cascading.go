@@ -0,0 +1,144 @@
+package search
+
+import "golang.org/x/exp/constraints"
+
+// cascadeEntry is one element of an augmented catalog.
+type cascadeEntry[T constraints.Ordered] struct {
+	key    T
+	own    int // The lower bound of key in the original list at this level.
+	bridge int // The lower bound of key in the augmented catalog of the next level.
+}
+
+// FractionalCascading answers "where does key fall in each of k sorted lists" in
+// O(log n + k) time instead of the O(k log n) of k independent binary searches.
+//
+// Every list is augmented with every other element of the augmented list below it.
+// A single binary search in the first augmented list then locates the key in each
+// following list by following a bridge pointer and stepping back at most a couple of
+// places, since no more than two elements of the next list sit between any two
+// neighbours of the current one.
+type FractionalCascading[T constraints.Ordered] struct {
+	lists    [][]T
+	catalogs [][]cascadeEntry[T] // Augmented lists, each ending with a sentinel entry.
+}
+
+// FractionalCascadingBuilder collects sorted lists and builds a FractionalCascading over them.
+type FractionalCascadingBuilder[T constraints.Ordered] struct {
+	lists [][]T
+}
+
+// Add appends a list to the structure being built. The list must be sorted in ascending
+// order and must not be modified afterwards.
+func (self *FractionalCascadingBuilder[T]) Add(list []T) *FractionalCascadingBuilder[T] {
+	self.lists = append(self.lists, list)
+	return self
+}
+
+// Build constructs the augmented catalogs in O(total length) time.
+func (self *FractionalCascadingBuilder[T]) Build() *FractionalCascading[T] {
+	k := len(self.lists)
+	cascade := &FractionalCascading[T]{
+		lists:    append([][]T(nil), self.lists...),
+		catalogs: make([][]cascadeEntry[T], k),
+	}
+	var below []cascadeEntry[T]
+	for level := k - 1; level >= 0; level-- {
+		cascade.catalogs[level] = augment(cascade.lists[level], below)
+		below = cascade.catalogs[level]
+	}
+	return cascade
+}
+
+// FractionalCascadingInit builds a FractionalCascading over the given sorted lists.
+func FractionalCascadingInit[T constraints.Ordered](lists ...[]T) *FractionalCascading[T] {
+	builder := &FractionalCascadingBuilder[T]{}
+	for _, list := range lists {
+		builder.Add(list)
+	}
+	return builder.Build()
+}
+
+// Count returns the number of lists.
+func (self *FractionalCascading[T]) Count() int {
+	return len(self.lists)
+}
+
+// LowerBounds returns, for every list, the index of the first element that is not less
+// than key (or the length of the list if there is none).
+func (self *FractionalCascading[T]) LowerBounds(key T) []int {
+	positions := make([]int, len(self.lists))
+	if len(self.catalogs) == 0 {
+		return positions
+	}
+
+	catalog := self.catalogs[0]
+	// The last entry is a sentinel, so search only the real entries.
+	lowerBound, upperBound := 0, len(catalog)-1
+	for lowerBound < upperBound {
+		midIndex := lowerBound + (upperBound-lowerBound)/2
+		if catalog[midIndex].key < key {
+			lowerBound = midIndex + 1
+		} else {
+			upperBound = midIndex
+		}
+	}
+
+	position := lowerBound
+	for level := range self.catalogs {
+		entry := self.catalogs[level][position]
+		positions[level] = entry.own
+		if level+1 < len(self.catalogs) {
+			next := self.catalogs[level+1]
+			position = entry.bridge
+			for position > 0 && !(next[position-1].key < key) {
+				position--
+			}
+		}
+	}
+	return positions
+}
+
+// Search returns, for every list, the lower bound of key and whether the element at that
+// position equals key, mirroring BinarySearch for each list.
+func (self *FractionalCascading[T]) Search(key T) ([]int, []bool) {
+	positions := self.LowerBounds(key)
+	found := make([]bool, len(positions))
+	for level, position := range positions {
+		list := self.lists[level]
+		found[level] = position < len(list) && list[position] == key
+	}
+	return positions, found
+}
+
+// augment merges list with every other entry of the catalog below it, and records for
+// each merged entry its lower bound in list and in the catalog below.
+func augment[T constraints.Ordered](list []T, below []cascadeEntry[T]) []cascadeEntry[T] {
+	sentinel := 0
+	if len(below) > 0 {
+		sentinel = len(below) - 1
+	}
+	catalog := make([]cascadeEntry[T], 0, len(list)+sentinel/2+1)
+
+	// Merge, taking promoted keys first on ties so that own stays a lower bound.
+	i, j := 0, 1
+	for i < len(list) || j < sentinel {
+		if j < sentinel && (i == len(list) || below[j].key <= list[i]) {
+			catalog = append(catalog, cascadeEntry[T]{key: below[j].key, own: i})
+			j += 2
+		} else {
+			catalog = append(catalog, cascadeEntry[T]{key: list[i], own: i})
+			i++
+		}
+	}
+	catalog = append(catalog, cascadeEntry[T]{own: len(list), bridge: sentinel})
+
+	// Bridges: the lower bound of each key in the catalog below, found by a linear sweep.
+	position := 0
+	for index := 0; index < len(catalog)-1; index++ {
+		for position < sentinel && below[position].key < catalog[index].key {
+			position++
+		}
+		catalog[index].bridge = position
+	}
+	return catalog
+}
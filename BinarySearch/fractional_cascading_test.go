@@ -0,0 +1,72 @@
+package search
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+	"golang.org/x/exp/slices"
+)
+
+func naiveLowerBounds(lists [][]int, key int) []int {
+	positions := make([]int, len(lists))
+	for i, list := range lists {
+		positions[i] = LowerBound(list, key)
+	}
+	return positions
+}
+
+func TestFractionalCascadingEmpty(t *testing.T) {
+	cascade := (&FractionalCascadingBuilder[int]{}).Build()
+	AssertEqual(cascade.Count(), 0, t)
+	AssertEqual(len(cascade.LowerBounds(3)), 0, t)
+
+	cascade = FractionalCascadingInit([]int{}, []int{}, []int{1})
+	AssertEqualSlice(cascade.LowerBounds(0), []int{0, 0, 0}, t)
+	AssertEqualSlice(cascade.LowerBounds(2), []int{0, 0, 1}, t)
+}
+
+func TestFractionalCascadingSearch(t *testing.T) {
+	cascade := (&FractionalCascadingBuilder[int]{}).
+		Add([]int{2, 4, 6, 8}).
+		Add([]int{1, 4, 9}).
+		Add([]int{4, 4, 4, 5}).
+		Add([]int{10, 20}).
+		Build()
+	AssertEqual(cascade.Count(), 4, t)
+
+	positions, found := cascade.Search(4)
+	AssertEqualSlice(positions, []int{1, 1, 0, 0}, t)
+	AssertEqualSlice(found, []bool{true, true, true, false}, t)
+
+	positions, found = cascade.Search(9)
+	AssertEqualSlice(positions, []int{4, 2, 4, 0}, t)
+	AssertEqualSlice(found, []bool{false, true, false, false}, t)
+
+	positions, found = cascade.Search(21)
+	AssertEqualSlice(positions, []int{4, 3, 4, 2}, t)
+	AssertEqualSlice(found, []bool{false, false, false, false}, t)
+}
+
+func TestFractionalCascadingAgainstNaive(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	for trial := 0; trial < 20; trial++ {
+		lists := make([][]int, 1+random.Intn(30))
+		for i := range lists {
+			list := make([]int, random.Intn(200))
+			for j := range list {
+				list[j] = random.Intn(1000)
+			}
+			sort.Ints(list)
+			lists[i] = list
+		}
+		cascade := FractionalCascadingInit(lists...)
+		for key := -1; key <= 1001; key++ {
+			expected := naiveLowerBounds(lists, key)
+			if got := cascade.LowerBounds(key); !slices.Equal(got, expected) {
+				t.Fatalf("trial %d key %d: expected %v got %v", trial, key, expected, got)
+			}
+		}
+	}
+}
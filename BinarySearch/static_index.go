@@ -0,0 +1,166 @@
+package search
+
+import (
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Layout selects how a StaticIndex arranges its keys in memory.
+type Layout int
+
+const (
+	// Eytzinger stores the keys in breadth-first order of an implicit binary search tree,
+	// so the first few levels of every search share the same few cache lines.
+	Eytzinger Layout = iota
+	// BTree stores the keys in nodes of BlockSize keys laid out like an implicit B-tree,
+	// so every node visited is a single contiguous block.
+	BTree
+)
+
+// BlockSize is the number of keys per node in the BTree layout. Sixteen 4-byte keys
+// fill one 64-byte cache line.
+const BlockSize = 16
+
+// StaticIndex is a read-only search structure built from a sorted slice. It answers the
+// same queries as BinarySearch and LowerBound, and reports positions in the original
+// sorted slice, but arranges the keys so that lookups touch fewer cache lines.
+type StaticIndex[T constraints.Ordered] struct {
+	layout Layout
+	count  int
+	keys   []T   // The keys in layout order.
+	ranks  []int // ranks[i] is the position of keys[i] in the sorted slice.
+}
+
+// StaticIndexInit builds an index over sorted using the given layout. The slice must be
+// sorted in ascending order and is not retained.
+func StaticIndexInit[T constraints.Ordered](sorted []T, layout Layout) *StaticIndex[T] {
+	index := &StaticIndex[T]{layout: layout, count: len(sorted)}
+	switch layout {
+	case Eytzinger:
+		// Slot 0 is unused so that the children of slot k are 2k and 2k+1.
+		index.keys = make([]T, len(sorted)+1)
+		index.ranks = make([]int, len(sorted)+1)
+		index.ranks[0] = len(sorted)
+		var next int
+		index.buildEytzinger(sorted, &next, 1)
+	case BTree:
+		blocks := (len(sorted) + BlockSize - 1) / BlockSize
+		index.keys = make([]T, blocks*BlockSize)
+		index.ranks = make([]int, blocks*BlockSize)
+		var next int
+		index.buildBTree(sorted, &next, 0, blocks)
+	default:
+		panic("search: unknown layout")
+	}
+	return index
+}
+
+// Count returns the number of keys in the index.
+func (self *StaticIndex[T]) Count() int {
+	return self.count
+}
+
+// LowerBound returns the position in the sorted slice of the first key that is not less
+// than key, or Count() if every key is less than key.
+func (self *StaticIndex[T]) LowerBound(key T) int {
+	if self.layout == Eytzinger {
+		return self.ranks[self.eytzingerLowerBound(key)]
+	}
+	return self.bTreeLowerBound(key)
+}
+
+// BinarySearch returns the position in the sorted slice of an element equal to key, and
+// whether one was found. With duplicate keys it returns the first of them.
+func (self *StaticIndex[T]) BinarySearch(key T) (int, bool) {
+	if self.layout == Eytzinger {
+		if slot := self.eytzingerLowerBound(key); slot != 0 && self.keys[slot] == key {
+			return self.ranks[slot], true
+		}
+		return -1, false
+	}
+	if slot := self.bTreeSlot(key); slot >= 0 && self.keys[slot] == key {
+		return self.ranks[slot], true
+	}
+	return -1, false
+}
+
+// buildEytzinger fills the subtree rooted at slot k by an in-order walk,
+// which places the sorted keys in breadth-first order.
+func (self *StaticIndex[T]) buildEytzinger(sorted []T, next *int, k int) {
+	if k <= len(sorted) {
+		self.buildEytzinger(sorted, next, 2*k)
+		self.keys[k] = sorted[*next]
+		self.ranks[k] = *next
+		*next += 1
+		self.buildEytzinger(sorted, next, 2*k+1)
+	}
+}
+
+// eytzingerLowerBound returns the slot holding the lower bound of key, or 0 if there is none.
+func (self *StaticIndex[T]) eytzingerLowerBound(key T) int {
+	k := 1
+	for k <= self.count {
+		if self.keys[k] < key {
+			k = 2*k + 1
+		} else {
+			k = 2 * k
+		}
+	}
+	// The path ends with a run of right turns (1 bits) after the last left turn. Dropping
+	// them and the left turn itself leaves the last node that was not less than key.
+	return k >> (bits.TrailingZeros(^uint(k)) + 1)
+}
+
+// bTreeChild returns the index of the i-th child of block k.
+func bTreeChild(k, i int) int {
+	return k*(BlockSize+1) + i + 1
+}
+
+// buildBTree fills block k and its descendants by an in-order walk. Slots left over once
+// the sorted keys run out are padding: they repeat the largest key, so that a search
+// treats them as copies of it that come after every real key.
+func (self *StaticIndex[T]) buildBTree(sorted []T, next *int, k, blocks int) {
+	if k < blocks {
+		for i := 0; i < BlockSize; i++ {
+			self.buildBTree(sorted, next, bTreeChild(k, i), blocks)
+			slot := k*BlockSize + i
+			if *next < len(sorted) {
+				self.keys[slot] = sorted[*next]
+				self.ranks[slot] = *next
+				*next += 1
+			} else {
+				self.keys[slot] = sorted[len(sorted)-1]
+				self.ranks[slot] = len(sorted)
+			}
+		}
+		self.buildBTree(sorted, next, bTreeChild(k, BlockSize), blocks)
+	}
+}
+
+// bTreeSlot returns the slot holding the lower bound of key, or -1 if there is none.
+// A padding slot can be picked on the way down, but only if the largest key is not less
+// than key, and then a deeper block holds a real key that replaces it.
+func (self *StaticIndex[T]) bTreeSlot(key T) int {
+	result := -1
+	blocks := len(self.keys) / BlockSize
+	for k := 0; k < blocks; {
+		block := self.keys[k*BlockSize : (k+1)*BlockSize]
+		i := 0
+		for i < BlockSize && block[i] < key {
+			i++
+		}
+		if i < BlockSize {
+			result = k*BlockSize + i
+		}
+		k = bTreeChild(k, i)
+	}
+	return result
+}
+
+func (self *StaticIndex[T]) bTreeLowerBound(key T) int {
+	if slot := self.bTreeSlot(key); slot >= 0 {
+		return self.ranks[slot]
+	}
+	return self.count
+}
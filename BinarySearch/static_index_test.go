@@ -0,0 +1,148 @@
+package search
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+var layouts = []Layout{Eytzinger, BTree}
+
+func TestStaticIndexEmpty(t *testing.T) {
+	for _, layout := range layouts {
+		index := StaticIndexInit([]int{}, layout)
+		AssertEqual(index.Count(), 0, t)
+		AssertEqual(index.LowerBound(5), 0, t)
+		_, valid := index.BinarySearch(5)
+		AssertFalse(valid, t)
+	}
+}
+
+func TestStaticIndexMatchesLowerBound(t *testing.T) {
+	for _, layout := range layouts {
+		// Sizes around block and level boundaries of both layouts.
+		for _, size := range []int{1, 2, 3, 7, 15, 16, 17, 31, 32, 33, 100, 272, 273, 1000} {
+			sorted := make([]int, size)
+			for i := range sorted {
+				sorted[i] = 2 * i
+			}
+			index := StaticIndexInit(sorted, layout)
+			for key := -1; key <= 2*size; key++ {
+				expected := LowerBound(sorted, key)
+				if got := index.LowerBound(key); got != expected {
+					t.Fatalf("layout %d size %d key %d: expected %d got %d", layout, size, key, expected, got)
+				}
+				position, valid := index.BinarySearch(key)
+				if valid != (key%2 == 0 && key >= 0 && key < 2*size) {
+					t.Fatalf("layout %d size %d key %d: unexpected valid %v", layout, size, key, valid)
+				}
+				if valid && sorted[position] != key {
+					t.Fatalf("layout %d size %d key %d: wrong position %d", layout, size, key, position)
+				}
+			}
+		}
+	}
+}
+
+func TestStaticIndexDuplicates(t *testing.T) {
+	sorted := []string{"a", "b", "b", "b", "c", "c", "d"}
+	for _, layout := range layouts {
+		index := StaticIndexInit(sorted, layout)
+		position, valid := index.BinarySearch("b")
+		AssertTrue(valid, t)
+		AssertEqual(position, 1, t)
+		AssertEqual(index.LowerBound("c"), 4, t)
+		AssertEqual(index.LowerBound("bb"), 4, t)
+		AssertEqual(index.LowerBound("e"), 7, t)
+	}
+}
+
+func TestStaticIndexDuplicateLargestKey(t *testing.T) {
+	// The padding repeats the largest key, which must not shadow its real copies.
+	for _, size := range []int{1, 2, 17, 20, 33, 300} {
+		sorted := make([]int, size)
+		for i := range sorted {
+			sorted[i] = i / 2
+		}
+		for i := size - size/3 - 1; i < size; i++ {
+			sorted[i] = size
+		}
+		index := StaticIndexInit(sorted, BTree)
+		for key := -1; key <= size+1; key++ {
+			expected := LowerBound(sorted, key)
+			if got := index.LowerBound(key); got != expected {
+				t.Fatalf("size %d key %d: expected %d got %d", size, key, expected, got)
+			}
+		}
+		position, valid := index.BinarySearch(size)
+		AssertTrue(valid, t)
+		AssertEqual(position, LowerBound(sorted, size), t)
+	}
+}
+
+func TestStaticIndexRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	sorted := make([]float64, 5000)
+	for i := range sorted {
+		sorted[i] = random.Float64()
+	}
+	sort.Float64s(sorted)
+	for _, layout := range layouts {
+		index := StaticIndexInit(sorted, layout)
+		for i := 0; i < 2000; i++ {
+			key := random.Float64()
+			AssertEqual(index.LowerBound(key), LowerBound(sorted, key), t)
+		}
+	}
+}
+
+const benchmarkSize = 1 << 20
+
+func benchmarkKeys() ([]int32, []int32) {
+	random := rand.New(rand.NewSource(42))
+	sorted := make([]int32, benchmarkSize)
+	for i := range sorted {
+		sorted[i] = int32(3 * i)
+	}
+	queries := make([]int32, 4096)
+	for i := range queries {
+		queries[i] = random.Int31n(3 * benchmarkSize)
+	}
+	return sorted, queries
+}
+
+func BenchmarkBinarySearch(b *testing.B) {
+	sorted, queries := benchmarkKeys()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BinarySearch(sorted, queries[i%len(queries)])
+	}
+}
+
+func BenchmarkLowerBound(b *testing.B) {
+	sorted, queries := benchmarkKeys()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LowerBound(sorted, queries[i%len(queries)])
+	}
+}
+
+func BenchmarkEytzingerLowerBound(b *testing.B) {
+	sorted, queries := benchmarkKeys()
+	index := StaticIndexInit(sorted, Eytzinger)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.LowerBound(queries[i%len(queries)])
+	}
+}
+
+func BenchmarkBTreeLowerBound(b *testing.B) {
+	sorted, queries := benchmarkKeys()
+	index := StaticIndexInit(sorted, BTree)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.LowerBound(queries[i%len(queries)])
+	}
+}
@@ -0,0 +1,237 @@
+// Package history provides an undo/redo command history built on stacks.
+package history
+
+import (
+	"errors"
+
+	. "github.com/Jcowwell/go-algorithm-club/Stack"
+)
+
+var (
+	// ErrNothingToUndo is returned by Undo when the history is empty.
+	ErrNothingToUndo = errors.New("history: nothing to undo")
+	// ErrNothingToRedo is returned by Redo when no command has been undone since the last Do.
+	ErrNothingToRedo = errors.New("history: nothing to redo")
+	// ErrTransactionOpen is returned by Undo and Redo while a transaction is open.
+	ErrTransactionOpen = errors.New("history: transaction in progress")
+	// ErrNoTransaction is returned by Commit and Rollback when no transaction is open.
+	ErrNoTransaction = errors.New("history: no transaction in progress")
+)
+
+/*
+An undoable action. Revert must undo exactly what Apply did, and Apply must
+be able to run again after Revert (that is what Redo does).
+*/
+type Command interface {
+	Apply() error
+	Revert() error
+}
+
+/*
+Several commands that are undone and redone as one. Commands are applied in
+order and reverted in reverse order.
+*/
+type group struct {
+	commands []Command
+}
+
+/*
+Applies every command. If one fails, the ones already applied are reverted.
+*/
+func (self *group) Apply() error {
+	for index, command := range self.commands {
+		if err := command.Apply(); err != nil {
+			revertAll(self.commands[:index])
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Reverts every command. If one fails, the ones already reverted are applied again.
+*/
+func (self *group) Revert() error {
+	for index := len(self.commands) - 1; index >= 0; index-- {
+		if err := self.commands[index].Revert(); err != nil {
+			for _, command := range self.commands[index+1:] {
+				command.Apply()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func revertAll(commands []Command) error {
+	for index := len(commands) - 1; index >= 0; index-- {
+		if err := commands[index].Revert(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Undo/redo history of commands.
+
+Commands that have been done sit on the undo stack and commands that have
+been undone sit on the redo stack. Doing a new command after an undo starts a
+new branch of history, so the redo stack is cleared. Commands can be grouped
+into transactions that are undone and redone as a single step, and the
+history can be capped so that the oldest entries are dropped.
+*/
+type History struct {
+	undo         Stack[*group] // Every entry is a group, even a single command.
+	redo         Stack[*group]
+	transactions Stack[*group] // Open transactions, innermost on top.
+	capacity     int           // The maximum number of undo entries, or 0 for no limit.
+}
+
+/*
+Creates an empty history that keeps at most capacity undo entries, dropping
+the oldest ones first. A capacity of 0 means no limit.
+*/
+func HistoryInit(capacity int) *History {
+	if capacity < 0 {
+		panic("capacity must be greater or equal to 0")
+	}
+	return &History{capacity: capacity}
+}
+
+func (self *History) CanUndo() bool {
+	return !self.undo.IsEmpty() && self.transactions.IsEmpty()
+}
+
+func (self *History) CanRedo() bool {
+	return !self.redo.IsEmpty() && self.transactions.IsEmpty()
+}
+
+/*
+Returns the number of entries that can be undone. A transaction counts as one entry.
+*/
+func (self *History) UndoCount() int {
+	return self.undo.Count()
+}
+
+/*
+Returns the number of entries that can be redone. A transaction counts as one entry.
+*/
+func (self *History) RedoCount() int {
+	return self.redo.Count()
+}
+
+/*
+Applies a command and records it. If a transaction is open, the command
+becomes part of it; otherwise it becomes a new undo entry and discards
+everything that could have been redone. A command that fails to apply is not
+recorded.
+*/
+func (self *History) Do(command Command) error {
+	if err := command.Apply(); err != nil {
+		return err
+	}
+	if transaction, open := self.transactions.Peek(); open {
+		transaction.commands = append(transaction.commands, command)
+		return nil
+	}
+	self.record(&group{commands: []Command{command}})
+	self.redo = nil
+	return nil
+}
+
+/*
+Reverts the most recent entry and moves it to the redo stack.
+*/
+func (self *History) Undo() error {
+	if !self.transactions.IsEmpty() {
+		return ErrTransactionOpen
+	}
+	entry, valid := self.undo.Pop()
+	if !valid {
+		return ErrNothingToUndo
+	}
+	if err := entry.Revert(); err != nil {
+		self.undo.Push(entry)
+		return err
+	}
+	self.redo.Push(entry)
+	return nil
+}
+
+/*
+Applies the most recently undone entry again and moves it back to the undo stack.
+*/
+func (self *History) Redo() error {
+	if !self.transactions.IsEmpty() {
+		return ErrTransactionOpen
+	}
+	entry, valid := self.redo.Pop()
+	if !valid {
+		return ErrNothingToRedo
+	}
+	if err := entry.Apply(); err != nil {
+		self.redo.Push(entry)
+		return err
+	}
+	self.record(entry)
+	return nil
+}
+
+/*
+Opens a transaction. Every command done until the matching Commit is undone
+and redone as one entry. Transactions can be nested; an inner transaction
+becomes a single step of the outer one.
+*/
+func (self *History) Begin() {
+	self.transactions.Push(&group{})
+}
+
+/*
+Closes the innermost transaction. A transaction without commands leaves no entry.
+*/
+func (self *History) Commit() error {
+	transaction, open := self.transactions.Pop()
+	if !open {
+		return ErrNoTransaction
+	}
+	if len(transaction.commands) == 0 {
+		return nil
+	}
+	if outer, nested := self.transactions.Peek(); nested {
+		outer.commands = append(outer.commands, transaction)
+		return nil
+	}
+	self.record(transaction)
+	self.redo = nil
+	return nil
+}
+
+/*
+Closes the innermost transaction and reverts every command done in it.
+*/
+func (self *History) Rollback() error {
+	transaction, open := self.transactions.Pop()
+	if !open {
+		return ErrNoTransaction
+	}
+	return revertAll(transaction.commands)
+}
+
+/*
+Forgets every entry. Open transactions are left as they are.
+*/
+func (self *History) Clear() {
+	self.undo = nil
+	self.redo = nil
+}
+
+/*
+Pushes an entry onto the undo stack, dropping the oldest entry when over capacity.
+*/
+func (self *History) record(entry *group) {
+	self.undo.Push(entry)
+	if self.capacity > 0 && self.undo.Count() > self.capacity {
+		self.undo.RemoveBottom() // The bottom of the stack is the oldest entry.
+	}
+}
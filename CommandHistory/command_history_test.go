@@ -0,0 +1,199 @@
+package history
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+type document struct {
+	text string
+}
+
+// insert appends text to a document.
+type insert struct {
+	document *document
+	text     string
+}
+
+func (self *insert) Apply() error {
+	self.document.text += self.text
+	return nil
+}
+
+func (self *insert) Revert() error {
+	self.document.text = self.document.text[:len(self.document.text)-len(self.text)]
+	return nil
+}
+
+var errFailed = errors.New("failed")
+
+// failing fails to apply once it has been applied limit times.
+type failing struct {
+	applied int
+	limit   int
+}
+
+func (self *failing) Apply() error {
+	if self.applied >= self.limit {
+		return errFailed
+	}
+	self.applied++
+	return nil
+}
+
+func (self *failing) Revert() error {
+	return nil
+}
+
+func TestEmptyHistory(t *testing.T) {
+	history := HistoryInit(0)
+	AssertFalse(history.CanUndo(), t)
+	AssertFalse(history.CanRedo(), t)
+	AssertTrue(history.Undo() == ErrNothingToUndo, t)
+	AssertTrue(history.Redo() == ErrNothingToRedo, t)
+	AssertTrue(history.Commit() == ErrNoTransaction, t)
+	AssertTrue(history.Rollback() == ErrNoTransaction, t)
+}
+
+func TestUndoRedo(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Do(&insert{doc, "Hello"})
+	history.Do(&insert{doc, ", "})
+	history.Do(&insert{doc, "World"})
+	AssertEqual(doc.text, "Hello, World", t)
+	AssertEqual(history.UndoCount(), 3, t)
+
+	AssertTrue(history.Undo() == nil, t)
+	AssertEqual(doc.text, "Hello, ", t)
+	AssertTrue(history.Undo() == nil, t)
+	AssertEqual(doc.text, "Hello", t)
+	AssertEqual(history.RedoCount(), 2, t)
+
+	AssertTrue(history.Redo() == nil, t)
+	AssertEqual(doc.text, "Hello, ", t)
+	AssertTrue(history.Redo() == nil, t)
+	AssertEqual(doc.text, "Hello, World", t)
+	AssertFalse(history.CanRedo(), t)
+}
+
+func TestBranchingAfterUndo(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Do(&insert{doc, "a"})
+	history.Do(&insert{doc, "b"})
+	history.Undo()
+	AssertTrue(history.CanRedo(), t)
+
+	// Doing something new abandons the undone branch.
+	history.Do(&insert{doc, "c"})
+	AssertEqual(doc.text, "ac", t)
+	AssertFalse(history.CanRedo(), t)
+	AssertTrue(history.Redo() == ErrNothingToRedo, t)
+
+	history.Undo()
+	history.Undo()
+	AssertEqual(doc.text, "", t)
+	history.Redo()
+	history.Redo()
+	AssertEqual(doc.text, "ac", t)
+}
+
+func TestTransactions(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Do(&insert{doc, "x"})
+	history.Begin()
+	history.Do(&insert{doc, "1"})
+	history.Do(&insert{doc, "2"})
+	AssertTrue(history.Undo() == ErrTransactionOpen, t)
+	AssertTrue(history.Commit() == nil, t)
+	AssertEqual(doc.text, "x12", t)
+	AssertEqual(history.UndoCount(), 2, t)
+
+	history.Undo()
+	AssertEqual(doc.text, "x", t)
+	history.Redo()
+	AssertEqual(doc.text, "x12", t)
+}
+
+func TestNestedTransactions(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Begin()
+	history.Do(&insert{doc, "a"})
+	history.Begin()
+	history.Do(&insert{doc, "b"})
+	history.Do(&insert{doc, "c"})
+	history.Commit()
+	history.Do(&insert{doc, "d"})
+	history.Commit()
+	AssertEqual(history.UndoCount(), 1, t)
+	AssertEqual(doc.text, "abcd", t)
+
+	history.Undo()
+	AssertEqual(doc.text, "", t)
+	history.Redo()
+	AssertEqual(doc.text, "abcd", t)
+}
+
+func TestRollback(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Do(&insert{doc, "keep"})
+	history.Begin()
+	history.Do(&insert{doc, "drop"})
+	history.Do(&insert{doc, "this"})
+	AssertTrue(history.Rollback() == nil, t)
+	AssertEqual(doc.text, "keep", t)
+	AssertEqual(history.UndoCount(), 1, t)
+
+	history.Begin()
+	AssertTrue(history.Commit() == nil, t)
+	AssertEqual(history.UndoCount(), 1, t)
+}
+
+func TestCapacityDropsOldest(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(2)
+	history.Do(&insert{doc, "a"})
+	history.Do(&insert{doc, "b"})
+	history.Do(&insert{doc, "c"})
+	AssertEqual(history.UndoCount(), 2, t)
+
+	history.Undo()
+	history.Undo()
+	AssertEqual(doc.text, "a", t)
+	AssertTrue(history.Undo() == ErrNothingToUndo, t)
+
+	history.Redo()
+	history.Redo()
+	AssertEqual(doc.text, "abc", t)
+	AssertEqual(history.UndoCount(), 2, t)
+}
+
+func TestFailingCommandIsNotRecorded(t *testing.T) {
+	history := HistoryInit(0)
+	command := &failing{limit: 1}
+	AssertTrue(history.Do(command) == nil, t)
+	AssertTrue(history.Do(command) == errFailed, t)
+	AssertEqual(history.UndoCount(), 1, t)
+
+	history.Undo()
+	// The command refuses to apply again, so the redo entry stays put.
+	AssertTrue(history.Redo() == errFailed, t)
+	AssertTrue(history.CanRedo(), t)
+}
+
+func TestClear(t *testing.T) {
+	doc := &document{}
+	history := HistoryInit(0)
+	history.Do(&insert{doc, "a"})
+	history.Do(&insert{doc, "b"})
+	history.Undo()
+	history.Clear()
+	AssertFalse(history.CanUndo(), t)
+	AssertFalse(history.CanRedo(), t)
+}
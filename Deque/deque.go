@@ -0,0 +1,205 @@
+package deque
+
+/*
+Deque (pronounced "deck"), a double-ended queue.
+
+The elements live in a growable circular buffer, so pushing and popping at
+either end are amortized O(1) operations and indexed access is O(1). The
+buffer doubles when it fills up and halves when it is less than a quarter full.
+*/
+type Deque[T any] struct {
+	slice []T // The circular buffer. Its length is always 0 or a power of two.
+	head  int // The index of the front element in the buffer.
+	count int // The number of elements in the deque.
+}
+
+const minimumCapacity = 8
+
+/*
+Creates an empty deque with room for at least capacity elements before it has to grow.
+*/
+func DequeInit[T any](capacity int) *Deque[T] {
+	size := minimumCapacity
+	for size < capacity {
+		size <<= 1
+	}
+	return &Deque[T]{slice: make([]T, size)}
+}
+
+func (self *Deque[T]) IsEmpty() bool {
+	return self.count == 0
+}
+
+func (self *Deque[T]) Count() int {
+	return self.count
+}
+
+/*
+Adds an element to the front of the deque. Performance: amortized O(1).
+*/
+func (self *Deque[T]) PushFront(element T) {
+	self.grow()
+	self.head = self.wrap(self.head - 1)
+	self.slice[self.head] = element
+	self.count += 1
+}
+
+/*
+Adds an element to the back of the deque. Performance: amortized O(1).
+*/
+func (self *Deque[T]) PushBack(element T) {
+	self.grow()
+	self.slice[self.wrap(self.head+self.count)] = element
+	self.count += 1
+}
+
+/*
+Removes and returns the front element. Performance: amortized O(1).
+*/
+func (self *Deque[T]) PopFront() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	var zero T
+	element := self.slice[self.head]
+	self.slice[self.head] = zero
+	self.head = self.wrap(self.head + 1)
+	self.count -= 1
+	self.shrink()
+	return element, true
+}
+
+/*
+Removes and returns the back element. Performance: amortized O(1).
+*/
+func (self *Deque[T]) PopBack() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	var zero T
+	index := self.wrap(self.head + self.count - 1)
+	element := self.slice[index]
+	self.slice[index] = zero
+	self.count -= 1
+	self.shrink()
+	return element, true
+}
+
+func (self *Deque[T]) PeekFront() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.slice[self.head], true
+}
+
+func (self *Deque[T]) PeekBack() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.slice[self.wrap(self.head+self.count-1)], true
+}
+
+/*
+Returns the element at a specific index, where 0 is the front. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *Deque[T]) At(index int) T {
+	self.checkIndex(index)
+	return self.slice[self.wrap(self.head+index)]
+}
+
+/*
+Replaces the element at a specific index, where 0 is the front. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *Deque[T]) Set(index int, element T) {
+	self.checkIndex(index)
+	self.slice[self.wrap(self.head+index)] = element
+}
+
+/*
+Calls body for every element from front to back, stopping early if body returns false.
+*/
+func (self *Deque[T]) ForEach(body func(index int, element T) bool) {
+	for index := 0; index < self.count; index++ {
+		if !body(index, self.slice[self.wrap(self.head+index)]) {
+			return
+		}
+	}
+}
+
+/*
+Returns the elements from front to back in a new slice.
+*/
+func (self *Deque[T]) Slice() []T {
+	result := make([]T, 0, self.count)
+	self.ForEach(func(_ int, element T) bool {
+		result = append(result, element)
+		return true
+	})
+	return result
+}
+
+/*
+Removes all elements, keeping the current buffer.
+*/
+func (self *Deque[T]) RemoveAll() {
+	var zero T
+	for index := range self.slice {
+		self.slice[index] = zero
+	}
+	self.head = 0
+	self.count = 0
+}
+
+func (self *Deque[T]) checkIndex(index int) {
+	if index < 0 || index >= self.count {
+		panic("index is out of bounds")
+	}
+}
+
+/*
+Maps a possibly out-of-range position onto the buffer. The buffer length is a power of two.
+*/
+func (self *Deque[T]) wrap(index int) int {
+	return index & (len(self.slice) - 1)
+}
+
+/*
+Doubles the buffer if it is full.
+*/
+func (self *Deque[T]) grow() {
+	if self.count < len(self.slice) {
+		return
+	}
+	size := len(self.slice) << 1
+	if size == 0 {
+		size = minimumCapacity
+	}
+	self.resize(size)
+}
+
+/*
+Halves the buffer if it is less than a quarter full.
+*/
+func (self *Deque[T]) shrink() {
+	if len(self.slice) > minimumCapacity && self.count < len(self.slice)/4 {
+		self.resize(len(self.slice) >> 1)
+	}
+}
+
+func (self *Deque[T]) resize(size int) {
+	slice := make([]T, size)
+	if self.count > 0 {
+		if end := self.head + self.count; end <= len(self.slice) {
+			copy(slice, self.slice[self.head:end])
+		} else {
+			n := copy(slice, self.slice[self.head:])
+			copy(slice[n:], self.slice[:end-len(self.slice)])
+		}
+	}
+	self.slice = slice
+	self.head = 0
+}
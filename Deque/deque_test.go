@@ -0,0 +1,144 @@
+package deque
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestEmpty(t *testing.T) {
+	deque := Deque[int]{}
+	AssertTrue(deque.IsEmpty(), t)
+	AssertEqual(deque.Count(), 0, t)
+	_, validFront := deque.PeekFront()
+	AssertFalse(validFront, t)
+	_, validBack := deque.PeekBack()
+	AssertFalse(validBack, t)
+	_, validPopFront := deque.PopFront()
+	AssertFalse(validPopFront, t)
+	_, validPopBack := deque.PopBack()
+	AssertFalse(validPopBack, t)
+}
+
+func TestPushAndPopBothEnds(t *testing.T) {
+	deque := Deque[int]{}
+	deque.PushBack(2)
+	deque.PushBack(3)
+	deque.PushFront(1)
+	deque.PushFront(0)
+	AssertEqual(deque.Count(), 4, t)
+	AssertEqualSlice(deque.Slice(), []int{0, 1, 2, 3}, t)
+
+	front, _ := deque.PeekFront()
+	AssertEqual(front, 0, t)
+	back, _ := deque.PeekBack()
+	AssertEqual(back, 3, t)
+
+	value, _ := deque.PopFront()
+	AssertEqual(value, 0, t)
+	value, _ = deque.PopBack()
+	AssertEqual(value, 3, t)
+	value, _ = deque.PopBack()
+	AssertEqual(value, 2, t)
+	value, _ = deque.PopFront()
+	AssertEqual(value, 1, t)
+	AssertTrue(deque.IsEmpty(), t)
+}
+
+func TestIndexedAccess(t *testing.T) {
+	deque := DequeInit[string](2)
+	for _, s := range []string{"c", "d", "e"} {
+		deque.PushBack(s)
+	}
+	deque.PushFront("b")
+	deque.PushFront("a")
+	for index, expected := range []string{"a", "b", "c", "d", "e"} {
+		AssertEqual(deque.At(index), expected, t)
+	}
+	deque.Set(2, "C")
+	AssertEqual(deque.At(2), "C", t)
+
+	visited := []string{}
+	deque.ForEach(func(index int, element string) bool {
+		visited = append(visited, element)
+		return index < 2
+	})
+	AssertEqualSlice(visited, []string{"a", "b", "C"}, t)
+}
+
+func TestIndexOutOfBounds(t *testing.T) {
+	deque := Deque[int]{}
+	deque.PushBack(1)
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	deque.At(1)
+}
+
+func TestGrowAndShrink(t *testing.T) {
+	deque := Deque[int]{}
+	for i := 0; i < 1000; i++ {
+		deque.PushFront(-i)
+		deque.PushBack(i)
+	}
+	AssertEqual(deque.Count(), 2000, t)
+	AssertEqual(deque.At(0), -999, t)
+	AssertEqual(deque.At(1999), 999, t)
+
+	for i := 999; i >= 0; i-- {
+		front, _ := deque.PopFront()
+		back, _ := deque.PopBack()
+		if front != -i || back != i {
+			t.Fatalf("expected %d and %d, got %d and %d", -i, i, front, back)
+		}
+	}
+	AssertTrue(deque.IsEmpty(), t)
+	AssertEqual(len(deque.slice), minimumCapacity, t)
+}
+
+func TestAgainstSlice(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	deque := Deque[int]{}
+	model := []int{}
+	for step := 0; step < 20000; step++ {
+		switch random.Intn(4) {
+		case 0:
+			deque.PushFront(step)
+			model = append([]int{step}, model...)
+		case 1:
+			deque.PushBack(step)
+			model = append(model, step)
+		case 2:
+			value, valid := deque.PopFront()
+			if valid != (len(model) > 0) || (valid && value != model[0]) {
+				t.Fatalf("step %d: PopFront mismatch", step)
+			}
+			if valid {
+				model = model[1:]
+			}
+		case 3:
+			value, valid := deque.PopBack()
+			if valid != (len(model) > 0) || (valid && value != model[len(model)-1]) {
+				t.Fatalf("step %d: PopBack mismatch", step)
+			}
+			if valid {
+				model = model[:len(model)-1]
+			}
+		}
+		if deque.Count() != len(model) {
+			t.Fatalf("step %d: expected count %d got %d", step, len(model), deque.Count())
+		}
+	}
+	AssertEqualSlice(deque.Slice(), model, t)
+}
+
+func TestRemoveAll(t *testing.T) {
+	deque := Deque[int]{}
+	deque.PushBack(1)
+	deque.PushFront(0)
+	deque.RemoveAll()
+	AssertTrue(deque.IsEmpty(), t)
+	deque.PushBack(5)
+	AssertEqualSlice(deque.Slice(), []int{5}, t)
+}
@@ -0,0 +1,149 @@
+package deque
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+type cacheLinePad [64]byte
+
+/*
+The circular array behind a WorkStealingDeque. Slots hold *T and are accessed
+atomically, because a thief may read a slot while the owner reuses it.
+*/
+type workStealingBuffer[T any] struct {
+	slots []unsafe.Pointer
+	mask  int64
+}
+
+func (self *workStealingBuffer[T]) load(index int64) *T {
+	return (*T)(atomic.LoadPointer(&self.slots[index&self.mask]))
+}
+
+func (self *workStealingBuffer[T]) store(index int64, element *T) {
+	atomic.StorePointer(&self.slots[index&self.mask], unsafe.Pointer(element))
+}
+
+/*
+Returns a buffer twice the size holding the elements between top and bottom.
+*/
+func (self *workStealingBuffer[T]) grow(top, bottom int64) *workStealingBuffer[T] {
+	size := int64(len(self.slots)) * 2
+	buffer := &workStealingBuffer[T]{slots: make([]unsafe.Pointer, size), mask: size - 1}
+	for index := top; index < bottom; index++ {
+		buffer.store(index, self.load(index))
+	}
+	return buffer
+}
+
+/*
+Chase-Lev work-stealing deque.
+
+One goroutine owns the deque and pushes and pops at the bottom, like a stack,
+while any number of other goroutines steal from the top, taking the oldest
+elements. The owner only has to synchronize with thieves when a single
+element is left, so its operations are nearly as cheap as a plain slice's.
+Thieves race each other with a compare-and-swap on top. The buffer grows as
+needed; it never shrinks.
+*/
+type WorkStealingDeque[T any] struct {
+	top    int64 // The index of the oldest element. Advanced by thieves (and the owner popping the last element).
+	_      cacheLinePad
+	bottom int64 // The index one past the newest element. Only written by the owner.
+	_      cacheLinePad
+	buffer unsafe.Pointer // *workStealingBuffer[T]
+}
+
+/*
+Creates an empty deque. The initial capacity is rounded up to a power of two.
+*/
+func WorkStealingDequeInit[T any](capacity int) *WorkStealingDeque[T] {
+	size := int64(minimumCapacity)
+	for size < int64(capacity) {
+		size <<= 1
+	}
+	buffer := &workStealingBuffer[T]{slots: make([]unsafe.Pointer, size), mask: size - 1}
+	return &WorkStealingDeque[T]{buffer: unsafe.Pointer(buffer)}
+}
+
+/*
+Returns the number of elements in the deque. It is only a snapshot while thieves are active.
+*/
+func (self *WorkStealingDeque[T]) Count() int {
+	top := atomic.LoadInt64(&self.top)
+	bottom := atomic.LoadInt64(&self.bottom)
+	if bottom < top {
+		return 0
+	}
+	return int(bottom - top)
+}
+
+func (self *WorkStealingDeque[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+/*
+Adds an element at the bottom. Must only be called by the owner. Performance: amortized O(1).
+*/
+func (self *WorkStealingDeque[T]) Push(element T) {
+	bottom := atomic.LoadInt64(&self.bottom)
+	top := atomic.LoadInt64(&self.top)
+	buffer := (*workStealingBuffer[T])(atomic.LoadPointer(&self.buffer))
+	if bottom-top >= int64(len(buffer.slots)) {
+		buffer = buffer.grow(top, bottom)
+		atomic.StorePointer(&self.buffer, unsafe.Pointer(buffer))
+	}
+	buffer.store(bottom, &element)
+	atomic.StoreInt64(&self.bottom, bottom+1)
+}
+
+/*
+Removes the newest element from the bottom. Must only be called by the owner.
+Returns false if the deque is empty or a thief took the last element. Performance: O(1).
+*/
+func (self *WorkStealingDeque[T]) Pop() (T, bool) {
+	var zero T
+	// Reserve the bottom element before looking at top, so a thief can't take it unnoticed.
+	bottom := atomic.LoadInt64(&self.bottom) - 1
+	buffer := (*workStealingBuffer[T])(atomic.LoadPointer(&self.buffer))
+	atomic.StoreInt64(&self.bottom, bottom)
+	top := atomic.LoadInt64(&self.top)
+
+	if top > bottom {
+		// Empty: undo the reservation.
+		atomic.StoreInt64(&self.bottom, bottom+1)
+		return zero, false
+	}
+
+	element := buffer.load(bottom)
+	if top == bottom {
+		// The last element: race the thieves for it.
+		won := atomic.CompareAndSwapInt64(&self.top, top, top+1)
+		atomic.StoreInt64(&self.bottom, bottom+1)
+		if !won {
+			return zero, false
+		}
+	}
+	return *element, true
+}
+
+/*
+Removes the oldest element from the top. Safe to call from any goroutine.
+Returns false if the deque is empty. Performance: O(1) without contention.
+*/
+func (self *WorkStealingDeque[T]) Steal() (T, bool) {
+	for {
+		top := atomic.LoadInt64(&self.top)
+		bottom := atomic.LoadInt64(&self.bottom)
+		if top >= bottom {
+			var zero T
+			return zero, false
+		}
+		buffer := (*workStealingBuffer[T])(atomic.LoadPointer(&self.buffer))
+		element := buffer.load(top)
+		if atomic.CompareAndSwapInt64(&self.top, top, top+1) {
+			return *element, true
+		}
+		// Another thief, or the owner, got there first. Try again.
+	}
+}
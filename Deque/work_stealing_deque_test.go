@@ -0,0 +1,97 @@
+package deque
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestWorkStealingDequeEmpty(t *testing.T) {
+	deque := WorkStealingDequeInit[int](0)
+	AssertTrue(deque.IsEmpty(), t)
+	_, validPop := deque.Pop()
+	AssertFalse(validPop, t)
+	_, validSteal := deque.Steal()
+	AssertFalse(validSteal, t)
+}
+
+func TestWorkStealingDequeOrder(t *testing.T) {
+	deque := WorkStealingDequeInit[int](2)
+	for i := 0; i < 100; i++ {
+		deque.Push(i)
+	}
+	AssertEqual(deque.Count(), 100, t)
+
+	// The owner pops the newest elements, thieves steal the oldest.
+	value, _ := deque.Pop()
+	AssertEqual(value, 99, t)
+	value, _ = deque.Steal()
+	AssertEqual(value, 0, t)
+	value, _ = deque.Steal()
+	AssertEqual(value, 1, t)
+	value, _ = deque.Pop()
+	AssertEqual(value, 98, t)
+	AssertEqual(deque.Count(), 96, t)
+
+	for i := 97; i >= 2; i-- {
+		value, valid := deque.Pop()
+		if !valid || value != i {
+			t.Fatalf("expected %d got %d (%v)", i, value, valid)
+		}
+	}
+	AssertTrue(deque.IsEmpty(), t)
+}
+
+func TestWorkStealingDequeStress(t *testing.T) {
+	const items, thieves = 100000, 4
+	deque := WorkStealingDequeInit[int](8)
+	taken := make([]int32, items)
+	var stolen, done int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if value, valid := deque.Steal(); valid {
+					atomic.AddInt32(&taken[value], 1)
+					atomic.AddInt32(&stolen, 1)
+				} else if atomic.LoadInt32(&done) == 1 {
+					return
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	// The owner pushes in bursts and pops some of its own work back.
+	for i := 0; i < items; i++ {
+		deque.Push(i)
+		if i%3 == 0 {
+			if value, valid := deque.Pop(); valid {
+				atomic.AddInt32(&taken[value], 1)
+			}
+		}
+	}
+	for {
+		value, valid := deque.Pop()
+		if !valid {
+			break
+		}
+		atomic.AddInt32(&taken[value], 1)
+	}
+	atomic.StoreInt32(&done, 1)
+	wg.Wait()
+
+	for value, count := range taken {
+		if count != 1 {
+			t.Fatalf("element %d was taken %d times", value, count)
+		}
+	}
+	AssertTrue(deque.IsEmpty(), t)
+}
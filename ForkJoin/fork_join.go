@@ -0,0 +1,183 @@
+// Package forkjoin provides a small work-stealing pool for parallel divide-and-conquer algorithms.
+package forkjoin
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/Jcowwell/go-algorithm-club/Deque"
+)
+
+/*
+A unit of work. It receives the worker running it, which it uses to fork
+subtasks.
+*/
+type Task func(worker *Worker)
+
+/*
+A goroutine of a Pool. It owns a work-stealing deque: tasks it forks are
+pushed and popped at the bottom, so it works depth-first on its own
+subproblems, while idle workers steal the oldest (and usually largest)
+subproblems from the top.
+*/
+type Worker struct {
+	pool   *Pool
+	index  int
+	deque  *WorkStealingDeque[Task]
+	random *rand.Rand
+}
+
+/*
+Fork-join pool. Submit a root task with Invoke; the task splits its work with
+Worker.Fork and every worker takes part until the whole computation is done.
+*/
+type Pool struct {
+	workers  []*Worker
+	incoming chan Task // Root tasks submitted by Invoke.
+	quit     chan struct{}
+	done     sync.WaitGroup
+	close    sync.Once
+}
+
+/*
+Creates a pool with the given number of workers, or runtime.GOMAXPROCS(0)
+workers if workers is not positive. Close the pool to stop them.
+*/
+func PoolInit(workers int) *Pool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	pool := &Pool{incoming: make(chan Task), quit: make(chan struct{})}
+	for index := 0; index < workers; index++ {
+		pool.workers = append(pool.workers, &Worker{
+			pool:   pool,
+			index:  index,
+			deque:  WorkStealingDequeInit[Task](64),
+			random: rand.New(rand.NewSource(int64(index) + 1)),
+		})
+	}
+	pool.done.Add(workers)
+	for _, worker := range pool.workers {
+		go worker.run()
+	}
+	return pool
+}
+
+func (self *Pool) Count() int {
+	return len(self.workers)
+}
+
+/*
+Runs task on the pool and waits until it, and every task it forked, has finished.
+Crashes if the pool has been closed.
+*/
+func (self *Pool) Invoke(task Task) {
+	finished := make(chan struct{})
+	select {
+	case self.incoming <- func(worker *Worker) {
+		defer close(finished)
+		task(worker)
+	}:
+	case <-self.quit:
+		panic("pool is closed")
+	}
+	<-finished
+}
+
+/*
+Stops the workers once they are idle. Tasks submitted with Invoke before Close still complete.
+*/
+func (self *Pool) Close() {
+	self.close.Do(func() { close(self.quit) })
+	self.done.Wait()
+}
+
+/*
+Runs every task in parallel and returns once all of them have finished. The
+first task runs on the calling worker and the rest are pushed onto its deque
+for it or other workers to pick up. While waiting, the worker keeps running
+other tasks rather than blocking, so nested forks can't deadlock the pool.
+*/
+func (self *Worker) Fork(tasks ...Task) {
+	if len(tasks) == 0 {
+		return
+	}
+	pending := int64(len(tasks))
+	for _, task := range tasks[1:] {
+		task := task
+		self.deque.Push(func(worker *Worker) {
+			defer atomic.AddInt64(&pending, -1)
+			task(worker)
+		})
+	}
+	tasks[0](self)
+	atomic.AddInt64(&pending, -1)
+
+	for atomic.LoadInt64(&pending) > 0 {
+		if task, valid := self.find(); valid {
+			task(self)
+		} else {
+			runtime.Gosched()
+		}
+	}
+}
+
+/*
+Returns the index of the worker within its pool.
+*/
+func (self *Worker) Index() int {
+	return self.index
+}
+
+func (self *Worker) run() {
+	defer self.pool.done.Done()
+	idle := 0
+	for {
+		if task, valid := self.find(); valid {
+			idle = 0
+			task(self)
+			continue
+		}
+		select {
+		case task := <-self.pool.incoming:
+			idle = 0
+			task(self)
+			continue
+		case <-self.pool.quit:
+			return
+		default:
+		}
+		// Back off gradually so an idle pool doesn't spin.
+		idle++
+		if idle < 64 {
+			runtime.Gosched()
+		} else {
+			time.Sleep(50 * time.Microsecond)
+		}
+	}
+}
+
+/*
+Returns the next task for the worker: the newest one it forked itself, or
+else the oldest one of a randomly chosen victim.
+*/
+func (self *Worker) find() (Task, bool) {
+	if task, valid := self.deque.Pop(); valid {
+		return task, true
+	}
+	workers := self.pool.workers
+	start := self.random.Intn(len(workers))
+	for offset := range workers {
+		victim := workers[(start+offset)%len(workers)]
+		if victim == self {
+			continue
+		}
+		if task, valid := victim.deque.Steal(); valid {
+			return task, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,142 @@
+package forkjoin
+
+import (
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+	"golang.org/x/exp/slices"
+)
+
+func parallelSum(worker *Worker, values []int, result *int) {
+	if len(values) <= 64 {
+		for _, value := range values {
+			*result += value
+		}
+		return
+	}
+	middle := len(values) / 2
+	var left, right int
+	worker.Fork(
+		func(worker *Worker) { parallelSum(worker, values[:middle], &left) },
+		func(worker *Worker) { parallelSum(worker, values[middle:], &right) },
+	)
+	*result = left + right
+}
+
+func parallelMergeSort(worker *Worker, values, scratch []int) {
+	if len(values) <= 32 {
+		sort.Ints(values)
+		return
+	}
+	middle := len(values) / 2
+	worker.Fork(
+		func(worker *Worker) { parallelMergeSort(worker, values[:middle], scratch[:middle]) },
+		func(worker *Worker) { parallelMergeSort(worker, values[middle:], scratch[middle:]) },
+	)
+	copy(scratch, values)
+	i, j, k := 0, middle, 0
+	for i < middle && j < len(values) {
+		if scratch[j] < scratch[i] {
+			values[k] = scratch[j]
+			j++
+		} else {
+			values[k] = scratch[i]
+			i++
+		}
+		k++
+	}
+	k += copy(values[k:], scratch[i:middle])
+	copy(values[k:], scratch[j:])
+}
+
+func TestPoolSum(t *testing.T) {
+	pool := PoolInit(4)
+	defer pool.Close()
+	AssertEqual(pool.Count(), 4, t)
+
+	values := make([]int, 50000)
+	for i := range values {
+		values[i] = i
+	}
+	var sum int
+	pool.Invoke(func(worker *Worker) { parallelSum(worker, values, &sum) })
+	AssertEqual(sum, 50000*49999/2, t)
+}
+
+func TestPoolMergeSort(t *testing.T) {
+	pool := PoolInit(0)
+	defer pool.Close()
+
+	random := rand.New(rand.NewSource(11))
+	values := make([]int, 50000)
+	for i := range values {
+		values[i] = random.Intn(1000000)
+	}
+	expected := slices.Clone(values)
+	sort.Ints(expected)
+
+	pool.Invoke(func(worker *Worker) { parallelMergeSort(worker, values, make([]int, len(values))) })
+	AssertTrue(slices.Equal(values, expected), t)
+}
+
+func TestPoolUsesSeveralWorkers(t *testing.T) {
+	pool := PoolInit(4)
+	defer pool.Close()
+
+	var used [4]int32
+	var visit func(worker *Worker, depth int)
+	visit = func(worker *Worker, depth int) {
+		atomic.StoreInt32(&used[worker.Index()], 1)
+		if depth == 0 {
+			for i := 0; i < 2000; i++ {
+				_ = rand.Int()
+			}
+			return
+		}
+		worker.Fork(
+			func(worker *Worker) { visit(worker, depth-1) },
+			func(worker *Worker) { visit(worker, depth-1) },
+		)
+	}
+	pool.Invoke(func(worker *Worker) { visit(worker, 12) })
+
+	count := 0
+	for index := range used {
+		count += int(atomic.LoadInt32(&used[index]))
+	}
+	AssertTrue(count > 1, t)
+}
+
+func TestPoolConcurrentInvokes(t *testing.T) {
+	pool := PoolInit(3)
+	defer pool.Close()
+
+	results := make(chan int, 8)
+	for i := 0; i < 8; i++ {
+		go func(i int) {
+			values := make([]int, 1000*(i+1))
+			for j := range values {
+				values[j] = 1
+			}
+			var sum int
+			pool.Invoke(func(worker *Worker) { parallelSum(worker, values, &sum) })
+			results <- sum - 1000*(i+1)
+		}(i)
+	}
+	for i := 0; i < 8; i++ {
+		AssertEqual(<-results, 0, t)
+	}
+}
+
+func TestPoolInvokeAfterClose(t *testing.T) {
+	pool := PoolInit(1)
+	pool.Close()
+	pool.Close()
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	pool.Invoke(func(worker *Worker) {})
+}
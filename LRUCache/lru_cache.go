@@ -0,0 +1,281 @@
+// Package lrucache provides a least-recently-used cache.
+package lrucache
+
+import (
+	"time"
+
+	. "github.com/Jcowwell/go-algorithm-club/LinkedList"
+)
+
+/*
+Why an entry left the cache, as reported to the eviction callback.
+*/
+type EvictionReason int
+
+const (
+	Evicted EvictionReason = iota // Dropped as the least recently used entry to make room.
+	Expired                       // Its time to live ran out.
+)
+
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	cost    int
+	expires time.Time // The zero time if the entry never expires.
+}
+
+/*
+Least-recently-used (LRU) cache.
+
+The entries are kept in a LinkedList ordered from most to least recently
+used, and a map points from each key to its node, so Get, Put and Remove are
+O(1): a hit moves the node to the front, and making room drops nodes from the
+back.
+
+Capacity is measured with a cost function, which by default counts every
+entry as 1 so that the capacity is the maximum number of entries. Entries can
+be given a time to live, after which Get treats them as missing; time comes
+from a clock that tests can replace. The cache is not safe for concurrent use.
+*/
+type LRUCache[K comparable, V any] struct {
+	list     LinkedList[*cacheEntry[K, V]] // The most recently used entry at the head.
+	nodes    map[K]*LinkedListNode[*cacheEntry[K, V]]
+	capacity int
+	cost     int // The total cost of the entries.
+	costOf   func(key K, value V) int
+	onEvict  func(key K, value V, reason EvictionReason)
+	clock    func() time.Time
+}
+
+/*
+Creates an empty cache holding entries whose costs add up to at most
+capacity. If cost is nil, every entry costs 1. Crashes if capacity is not
+positive; Put crashes if cost returns a negative value.
+*/
+func LRUCacheInit[K comparable, V any](capacity int, cost func(key K, value V) int) *LRUCache[K, V] {
+	if capacity <= 0 {
+		panic("capacity must be greater than 0")
+	}
+	if cost == nil {
+		cost = func(K, V) int { return 1 }
+	}
+	return &LRUCache[K, V]{
+		nodes:    map[K]*LinkedListNode[*cacheEntry[K, V]]{},
+		capacity: capacity,
+		costOf:   cost,
+		clock:    time.Now,
+	}
+}
+
+/*
+Sets a function to call whenever an entry is evicted to make room or found to
+have expired. It is not called for entries that are removed or replaced.
+*/
+func (self *LRUCache[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	self.onEvict = callback
+}
+
+/*
+Replaces the clock used to expire entries, time.Now by default.
+*/
+func (self *LRUCache[K, V]) SetClock(clock func() time.Time) {
+	self.clock = clock
+}
+
+/*
+Returns the number of entries, including expired ones that have not been noticed yet.
+*/
+func (self *LRUCache[K, V]) Count() int {
+	return self.list.Count()
+}
+
+func (self *LRUCache[K, V]) IsEmpty() bool {
+	return self.list.IsEmpty()
+}
+
+func (self *LRUCache[K, V]) Capacity() int {
+	return self.capacity
+}
+
+/*
+Returns the total cost of the entries.
+*/
+func (self *LRUCache[K, V]) Cost() int {
+	return self.cost
+}
+
+/*
+Returns the value stored for key and marks it as the most recently used.
+Performance: O(1).
+*/
+func (self *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, found := self.lookup(key)
+	if !found {
+		var value V
+		return value, false
+	}
+	self.list.RemoveNode(node)
+	self.list.InsertNode(node, 0)
+	return node.Value().value, true
+}
+
+/*
+Returns the value stored for key without marking it as used. Performance: O(1).
+*/
+func (self *LRUCache[K, V]) Peek(key K) (V, bool) {
+	node, found := self.lookup(key)
+	if !found {
+		var value V
+		return value, false
+	}
+	return node.Value().value, true
+}
+
+func (self *LRUCache[K, V]) Contains(key K) bool {
+	_, found := self.lookup(key)
+	return found
+}
+
+/*
+Stores value for key as the most recently used entry, replacing the value
+already stored for it, and evicts least recently used entries until the cost
+fits the capacity. An entry that costs more than the whole capacity is not
+stored, and false is returned; the value already stored for key, if any, is
+kept. Crashes if the cost of the entry is negative. Performance: O(1), plus
+O(1) per eviction.
+*/
+func (self *LRUCache[K, V]) Put(key K, value V) bool {
+	return self.put(key, value, time.Time{})
+}
+
+/*
+Like Put, but the entry expires once ttl has passed. Crashes if ttl is not positive.
+*/
+func (self *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	if ttl <= 0 {
+		panic("ttl must be greater than 0")
+	}
+	return self.put(key, value, self.clock().Add(ttl))
+}
+
+/*
+Removes key and returns the value that was stored for it. Performance: O(1).
+*/
+func (self *LRUCache[K, V]) Remove(key K) (V, bool) {
+	node, found := self.nodes[key]
+	if !found {
+		var value V
+		return value, false
+	}
+	entry := self.remove(node)
+	return entry.value, true
+}
+
+/*
+Removes every entry that has expired and returns how many there were. The
+eviction callback is called for each of them. Performance: O(n).
+*/
+func (self *LRUCache[K, V]) RemoveExpired() int {
+	now := self.clock()
+	removed := 0
+	for cursor := self.list.Front(); cursor.Valid(); {
+		entry := cursor.Value()
+		if !entry.expired(now) {
+			cursor.Next()
+			continue
+		}
+		cursor.Remove()
+		self.forget(entry)
+		self.evicted(entry, Expired)
+		removed += 1
+	}
+	return removed
+}
+
+/*
+Removes every entry without calling the eviction callback.
+*/
+func (self *LRUCache[K, V]) RemoveAll() {
+	self.list.RemoveAll()
+	self.nodes = map[K]*LinkedListNode[*cacheEntry[K, V]]{}
+	self.cost = 0
+}
+
+/*
+Returns the keys from the most to the least recently used.
+*/
+func (self *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, self.list.Count())
+	for cursor := self.list.Front(); cursor.Valid(); cursor.Next() {
+		keys = append(keys, cursor.Value().key)
+	}
+	return keys
+}
+
+func (self *LRUCache[K, V]) put(key K, value V, expires time.Time) bool {
+	cost := self.costOf(key, value)
+	if cost < 0 {
+		panic("cost must not be negative")
+	}
+	if cost > self.capacity {
+		return false
+	}
+	if node, found := self.nodes[key]; found {
+		self.remove(node)
+	}
+
+	now := self.clock()
+	for self.cost+cost > self.capacity {
+		entry := self.remove(self.list.Tail())
+		if entry.expired(now) {
+			self.evicted(entry, Expired)
+		} else {
+			self.evicted(entry, Evicted)
+		}
+	}
+	entry := &cacheEntry[K, V]{key: key, value: value, cost: cost, expires: expires}
+	self.list.InsertValue(entry, 0)
+	self.nodes[key] = self.list.Front().Node()
+	self.cost += cost
+	return true
+}
+
+/*
+Returns the node for key, removing it and reporting it as missing if it has expired.
+*/
+func (self *LRUCache[K, V]) lookup(key K) (*LinkedListNode[*cacheEntry[K, V]], bool) {
+	node, found := self.nodes[key]
+	if !found {
+		return nil, false
+	}
+	if entry := node.Value(); entry.expired(self.clock()) {
+		self.remove(node)
+		self.evicted(entry, Expired)
+		return nil, false
+	}
+	return node, true
+}
+
+func (self *LRUCache[K, V]) remove(node *LinkedListNode[*cacheEntry[K, V]]) *cacheEntry[K, V] {
+	entry := self.list.RemoveNode(node)
+	self.forget(entry)
+	return entry
+}
+
+/*
+Drops the map entry and the cost of an entry already unlinked from the list.
+*/
+func (self *LRUCache[K, V]) forget(entry *cacheEntry[K, V]) {
+	delete(self.nodes, entry.key)
+	self.cost -= entry.cost
+}
+
+func (self *LRUCache[K, V]) evicted(entry *cacheEntry[K, V], reason EvictionReason) {
+	if self.onEvict != nil {
+		self.onEvict(entry.key, entry.value, reason)
+	}
+}
+
+func (self *cacheEntry[K, V]) expired(now time.Time) bool {
+	return !self.expires.IsZero() && !now.Before(self.expires)
+}
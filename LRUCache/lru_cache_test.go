@@ -0,0 +1,225 @@
+package lrucache
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+type eviction struct {
+	key    string
+	value  int
+	reason EvictionReason
+}
+
+func recordEvictions(cache *LRUCache[string, int]) *[]eviction {
+	evictions := &[]eviction{}
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		*evictions = append(*evictions, eviction{key, value, reason})
+	})
+	return evictions
+}
+
+/*
+A clock that only moves when told to.
+*/
+type fakeClock struct {
+	now time.Time
+}
+
+func (self *fakeClock) Now() time.Time {
+	return self.now
+}
+
+func (self *fakeClock) Advance(duration time.Duration) {
+	self.now = self.now.Add(duration)
+}
+
+func TestEmptyCache(t *testing.T) {
+	cache := LRUCacheInit[string, int](2, nil)
+	AssertTrue(cache.IsEmpty(), t)
+	_, found := cache.Get("a")
+	AssertFalse(found, t)
+	_, found = cache.Remove("a")
+	AssertFalse(found, t)
+	AssertEqual(cache.Capacity(), 2, t)
+}
+
+func TestLeastRecentlyUsedIsEvicted(t *testing.T) {
+	cache := LRUCacheInit[string, int](3, nil)
+	evictions := recordEvictions(cache)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	AssertEqualSlice(cache.Keys(), []string{"c", "b", "a"}, t)
+
+	value, found := cache.Get("a")
+	AssertTrue(found, t)
+	AssertEqual(value, 1, t)
+	AssertEqualSlice(cache.Keys(), []string{"a", "c", "b"}, t)
+
+	cache.Put("d", 4)
+	AssertFalse(cache.Contains("b"), t)
+	AssertEqualSlice(cache.Keys(), []string{"d", "a", "c"}, t)
+	AssertEqualSlice(*evictions, []eviction{{"b", 2, Evicted}}, t)
+	AssertEqual(cache.Count(), 3, t)
+}
+
+func TestPeekDoesNotPromote(t *testing.T) {
+	cache := LRUCacheInit[string, int](2, nil)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	value, found := cache.Peek("a")
+	AssertTrue(found, t)
+	AssertEqual(value, 1, t)
+	cache.Put("c", 3)
+	AssertFalse(cache.Contains("a"), t)
+}
+
+func TestPutReplacesValue(t *testing.T) {
+	cache := LRUCacheInit[string, int](2, nil)
+	evictions := recordEvictions(cache)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10)
+	AssertEqual(cache.Count(), 2, t)
+	AssertEqualSlice(cache.Keys(), []string{"a", "b"}, t)
+	value, _ := cache.Get("a")
+	AssertEqual(value, 10, t)
+	AssertEqual(len(*evictions), 0, t)
+}
+
+func TestRemove(t *testing.T) {
+	cache := LRUCacheInit[string, int](2, nil)
+	evictions := recordEvictions(cache)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	value, found := cache.Remove("a")
+	AssertTrue(found, t)
+	AssertEqual(value, 1, t)
+	AssertEqualSlice(cache.Keys(), []string{"b"}, t)
+	AssertEqual(cache.Cost(), 1, t)
+	AssertEqual(len(*evictions), 0, t)
+
+	cache.RemoveAll()
+	AssertTrue(cache.IsEmpty(), t)
+	AssertEqual(cache.Cost(), 0, t)
+}
+
+func TestCostCapacity(t *testing.T) {
+	cache := LRUCacheInit(10, func(key string, value int) int { return value })
+	evictions := recordEvictions(cache)
+	cache.Put("a", 4)
+	cache.Put("b", 3)
+	cache.Put("c", 3)
+	AssertEqual(cache.Cost(), 10, t)
+
+	cache.Put("d", 5)
+	AssertEqualSlice(cache.Keys(), []string{"d", "c"}, t)
+	AssertEqual(cache.Cost(), 8, t)
+	AssertEqualSlice(*evictions, []eviction{{"a", 4, Evicted}, {"b", 3, Evicted}}, t)
+
+	// Replacing an entry updates its cost.
+	cache.Put("c", 1)
+	AssertEqual(cache.Cost(), 6, t)
+}
+
+func TestOversizedEntryIsNotStored(t *testing.T) {
+	cache := LRUCacheInit(10, func(key string, value int) int { return value })
+	evictions := recordEvictions(cache)
+	cache.Put("a", 5)
+	AssertFalse(cache.Put("b", 11), t)
+	AssertFalse(cache.Contains("b"), t)
+	AssertEqualSlice(cache.Keys(), []string{"a"}, t)
+
+	// Replacing an entry with an oversized value keeps the old one.
+	AssertFalse(cache.Put("a", 11), t)
+	value, found := cache.Peek("a")
+	AssertTrue(found, t)
+	AssertEqual(value, 5, t)
+	AssertEqual(cache.Cost(), 5, t)
+	AssertEqual(len(*evictions), 0, t)
+}
+
+func TestNegativeCostCrashes(t *testing.T) {
+	cache := LRUCacheInit(10, func(key string, value int) int { return value })
+	cache.Put("a", 10)
+	defer func() {
+		AssertTrue(recover() != nil, t)
+		AssertEqualSlice(cache.Keys(), []string{"a"}, t)
+		AssertEqual(cache.Cost(), 10, t)
+	}()
+	cache.Put("b", -5)
+}
+
+func TestTimeToLive(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := LRUCacheInit[string, int](10, nil)
+	cache.SetClock(clock.Now)
+	evictions := recordEvictions(cache)
+
+	cache.PutWithTTL("short", 1, time.Second)
+	cache.PutWithTTL("long", 2, time.Minute)
+	cache.Put("forever", 3)
+
+	clock.Advance(999 * time.Millisecond)
+	AssertTrue(cache.Contains("short"), t)
+
+	clock.Advance(time.Millisecond)
+	_, found := cache.Get("short")
+	AssertFalse(found, t)
+	AssertEqual(cache.Count(), 2, t)
+	AssertEqualSlice(*evictions, []eviction{{"short", 1, Expired}}, t)
+
+	clock.Advance(time.Hour)
+	_, found = cache.Peek("long")
+	AssertFalse(found, t)
+	value, found := cache.Get("forever")
+	AssertTrue(found, t)
+	AssertEqual(value, 3, t)
+	AssertEqual(len(*evictions), 2, t)
+}
+
+func TestPutResetsTimeToLive(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := LRUCacheInit[string, int](10, nil)
+	cache.SetClock(clock.Now)
+	cache.PutWithTTL("a", 1, time.Second)
+	clock.Advance(500 * time.Millisecond)
+	cache.Put("a", 2)
+	clock.Advance(time.Hour)
+	AssertTrue(cache.Contains("a"), t)
+}
+
+func TestRemoveExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := LRUCacheInit[string, int](10, nil)
+	cache.SetClock(clock.Now)
+	evictions := recordEvictions(cache)
+	cache.PutWithTTL("a", 1, time.Second)
+	cache.Put("b", 2)
+	cache.PutWithTTL("c", 3, time.Second)
+	cache.PutWithTTL("d", 4, time.Minute)
+
+	clock.Advance(time.Second)
+	AssertEqual(cache.RemoveExpired(), 2, t)
+	AssertEqualSlice(cache.Keys(), []string{"d", "b"}, t)
+	AssertEqual(cache.Cost(), 2, t)
+	AssertEqualSlice(*evictions, []eviction{{"c", 3, Expired}, {"a", 1, Expired}}, t)
+}
+
+func TestEvictingExpiredEntryReportsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := LRUCacheInit[string, int](2, nil)
+	cache.SetClock(clock.Now)
+	evictions := recordEvictions(cache)
+	cache.PutWithTTL("a", 1, time.Second)
+	cache.Put("b", 2)
+
+	clock.Advance(time.Second)
+	cache.Put("c", 3)
+	cache.Put("d", 4)
+	AssertEqualSlice(cache.Keys(), []string{"d", "c"}, t)
+	AssertEqualSlice(*evictions, []eviction{{"a", 1, Expired}, {"b", 2, Evicted}}, t)
+}
@@ -0,0 +1,122 @@
+package linkedlist
+
+/*
+A position in a LinkedList used to walk it in either direction and edit it
+while walking. Every operation is O(1).
+
+A cursor is either on a node of the list or off the list, which is where it
+ends up after stepping past either end. Value, Set and the editing methods
+crash when the cursor is off the list.
+
+	for cursor := list.Front(); cursor.Valid(); {
+		if cursor.Value() < 0 {
+			cursor.Remove() // Moves on to the next node.
+		} else {
+			cursor.Next()
+		}
+	}
+
+Editing the list other than through the cursor is fine, as long as the node
+the cursor is on isn't removed.
+*/
+type Cursor[T any] struct {
+	list *LinkedList[T]
+	node *LinkedListNode[T]
+}
+
+/*
+Method to return a cursor on the first node of the list, or off the list if it is empty.
+*/
+func (self *LinkedList[T]) Front() *Cursor[T] {
+	return &Cursor[T]{list: self, node: self.head}
+}
+
+/*
+Method to return a cursor on the last node of the list, or off the list if it is empty.
+*/
+func (self *LinkedList[T]) Back() *Cursor[T] {
+	return &Cursor[T]{list: self, node: self.tail}
+}
+
+/*
+Method to check if the cursor is on a node.
+*/
+func (self *Cursor[T]) Valid() bool {
+	return self.node != nil
+}
+
+/*
+Method to move to the next node. Returns false if the cursor stepped off the end of the list.
+*/
+func (self *Cursor[T]) Next() bool {
+	if self.node != nil {
+		self.node = self.node.next
+	}
+	return self.node != nil
+}
+
+/*
+Method to move to the previous node. Returns false if the cursor stepped off the front of the list.
+*/
+func (self *Cursor[T]) Prev() bool {
+	if self.node != nil {
+		self.node = self.node.prev
+	}
+	return self.node != nil
+}
+
+/*
+Method to return the node the cursor is on, or nil if it is off the list.
+*/
+func (self *Cursor[T]) Node() *LinkedListNode[T] {
+	return self.node
+}
+
+/*
+Method to return the value of the node the cursor is on.
+*/
+func (self *Cursor[T]) Value() T {
+	return self.current().value
+}
+
+/*
+Method to replace the value of the node the cursor is on.
+*/
+func (self *Cursor[T]) Set(value T) {
+	self.current().value = value
+}
+
+/*
+Method to insert a value in front of the cursor. The cursor stays where it is.
+*/
+func (self *Cursor[T]) InsertBefore(value T) {
+	self.list.insertBefore(LinkedListNodeInit(value), self.current())
+}
+
+/*
+Method to insert a value after the cursor. The cursor stays where it is.
+*/
+func (self *Cursor[T]) InsertAfter(value T) {
+	node := self.current()
+	if node.next == nil {
+		self.list.AppendValue(value)
+	} else {
+		self.list.insertBefore(LinkedListNodeInit(value), node.next)
+	}
+}
+
+/*
+Method to remove the node the cursor is on and move to the next node. Returns the removed value.
+*/
+func (self *Cursor[T]) Remove() T {
+	node := self.current()
+	self.node = node.next
+	return self.list.RemoveNode(node)
+}
+
+func (self *Cursor[T]) current() *LinkedListNode[T] {
+	if self.node == nil {
+		panic("cursor is off the list")
+	}
+	return self.node
+}
@@ -0,0 +1,86 @@
+package linkedlist
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func values[T any](list *LinkedList[T]) []T {
+	var result []T
+	for cursor := list.Front(); cursor.Valid(); cursor.Next() {
+		result = append(result, cursor.Value())
+	}
+	return result
+}
+
+func TestCursorOnEmptyList(t *testing.T) {
+	list := &LinkedList[int]{}
+	AssertFalse(list.Front().Valid(), t)
+	AssertFalse(list.Back().Valid(), t)
+	AssertFalse(list.Front().Next(), t)
+	AssertNil(list.Back().Node(), t)
+}
+
+func TestCursorWalksBothWays(t *testing.T) {
+	list := buildList([]int{1, 2, 3})
+	AssertEqualSlice(values(list), []int{1, 2, 3}, t)
+
+	var backwards []int
+	for cursor := list.Back(); cursor.Valid(); cursor.Prev() {
+		backwards = append(backwards, cursor.Value())
+	}
+	AssertEqualSlice(backwards, []int{3, 2, 1}, t)
+
+	cursor := list.Front()
+	AssertTrue(cursor.Next(), t)
+	AssertTrue(cursor.Node() == list.NodeAt(1), t)
+	AssertTrue(cursor.Prev(), t)
+	AssertFalse(cursor.Prev(), t)
+	AssertFalse(cursor.Valid(), t)
+}
+
+func TestCursorInsert(t *testing.T) {
+	list := buildList([]int{2, 4})
+	cursor := list.Front()
+	cursor.InsertBefore(1)
+	cursor.InsertAfter(3)
+	AssertEqual(cursor.Value(), 2, t)
+
+	cursor = list.Back()
+	cursor.InsertAfter(5)
+	AssertEqualSlice(values(list), []int{1, 2, 3, 4, 5}, t)
+	AssertEqual(list.Tail().Value(), 5, t)
+	AssertEqual(list.Count(), 5, t)
+	assertConsistent(list, t)
+}
+
+func TestCursorRemoveWhileWalking(t *testing.T) {
+	list := buildList([]int{-1, 1, -2, -3, 2, -4})
+	for cursor := list.Front(); cursor.Valid(); {
+		if cursor.Value() < 0 {
+			cursor.Remove()
+		} else {
+			cursor.Next()
+		}
+	}
+	AssertEqualSlice(values(list), []int{1, 2}, t)
+	AssertEqual(list.Count(), 2, t)
+	assertConsistent(list, t)
+}
+
+func TestCursorSet(t *testing.T) {
+	list := buildList([]int{1, 2, 3})
+	for cursor := list.Front(); cursor.Valid(); cursor.Next() {
+		cursor.Set(cursor.Value() * 10)
+	}
+	AssertEqualSlice(values(list), []int{10, 20, 30}, t)
+}
+
+func TestCursorOffListCrashes(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	list := &LinkedList[int]{}
+	list.Front().Remove()
+}
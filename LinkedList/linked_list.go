@@ -20,11 +20,23 @@ func LinkedListNodeInit[T any](value T) *LinkedListNode[T] {
 	return &LinkedListNode[T]{value: value}
 }
 
+/*
+Method to return the value of the node.
+*/
+func (self *LinkedListNode[T]) Value() T {
+	return self.value
+}
+
 /*
 Linked List's Struct
+
+The list keeps track of its tail and its length, so Count, Tail, appending
+and removing the last node are O(1).
 */
 type LinkedList[T any] struct {
-	head *LinkedListNode[T] // The head of the Linked List
+	head  *LinkedListNode[T] // The head of the Linked List
+	tail  *LinkedListNode[T] // The last node of the Linked List
+	count int                // The # of nodes in the Linked List
 }
 
 /*
@@ -35,36 +47,22 @@ func (self *LinkedList[T]) IsEmpty() bool {
 }
 
 /*
-Method to return # of elements in LinkedList.
+Method to return # of elements in LinkedList. Performance: O(1).
 */
 func (self *LinkedList[T]) Count() int {
-	if head := self.head; head != nil {
-		Count := 1
-		for node := head.next; node != nil; {
-			node = node.next
-			Count += 1
-		}
-		return Count
-	}
-	return 0
+	return self.count
 }
 
 /*
-Method to return last LinkedListNode in LinkedList.
+Method to return last LinkedListNode in LinkedList. Performance: O(1).
 */
 func (self *LinkedList[T]) Tail() *LinkedListNode[T] {
-	if head := self.head; head != nil {
-		node := self.head
-		for node.next != nil {
-			node = node.next
-		}
-		return node
-	}
-	return nil
+	return self.tail
 }
 
 /*
 Method to return the node at a specific index. Crashes if index is out of bounds (0...self.Count).
+Walks from whichever end of the list is closer.
 */
 func (self *LinkedList[T]) NodeAt(index int) *LinkedListNode[T] {
 	if self.IsEmpty() {
@@ -73,39 +71,41 @@ func (self *LinkedList[T]) NodeAt(index int) *LinkedListNode[T] {
 	if index < 0 {
 		panic("index must be greater or equal to 0")
 	}
+	if index >= self.count {
+		panic("index is out of bounds")
+	}
 
-	if index == 0 {
-		return self.head
-	} else {
-		node := self.head.next
-		for i := 1; i < index; i++ {
+	if index < self.count/2 {
+		node := self.head
+		for i := 0; i < index; i++ {
 			node = node.next
-			if node == nil {
-				break
-			}
-		}
-		if node == nil {
-			panic("index is out of bounds")
 		}
 		return node
 	}
+	node := self.tail
+	for i := self.count - 1; i > index; i-- {
+		node = node.prev
+	}
+	return node
 }
 
 /*
-Method to append a copy of a LinkedListNode to the end of the list.
+Method to append a LinkedListNode to the end of the list. Performance: O(1).
 */
 func (self *LinkedList[T]) AppendNode(node *LinkedListNode[T]) {
+	node.next = nil
+	node.prev = self.tail
 	if self.IsEmpty() {
 		self.head = node
 	} else {
-		lastNode := self.Tail()
-		node.prev = lastNode
-		lastNode.next = node
+		self.tail.next = node
 	}
+	self.tail = node
+	self.count += 1
 }
 
 /*
-Method to append a value to the end of the list.
+Method to append a value to the end of the list. Performance: O(1).
 */
 func (self *LinkedList[T]) AppendValue(value T) {
 	node := LinkedListNodeInit(value)
@@ -113,35 +113,46 @@ func (self *LinkedList[T]) AppendValue(value T) {
 }
 
 /*
-Method to append a copy of a LinkedList to the end of the list.
+Method to append a copy of a LinkedList to the end of the list. Performance: O(m) for m copied nodes.
 */
 func (self *LinkedList[T]) AppendList(list *LinkedList[T]) {
-	copyNode := list.head
-	for copyNode != nil {
-		node := copyNode
-		self.AppendValue(node.value)
-		copyNode = node.next
-	}
+	self.SpliceList(list.Copy(), self.count)
+}
+
+/*
+Method to move the nodes of a LinkedList to the end of the list, taking
+ownership of them: list is left empty. Performance: O(1).
+*/
+func (self *LinkedList[T]) MoveList(list *LinkedList[T]) {
+	self.SpliceList(list, self.count)
 }
 
 /*
-Method to insert a copy of a node at a specific index. Crashes if index is out of bounds (0...self.Count).
+Method to insert a node at a specific index. Crashes if index is out of bounds (0...self.Count).
 */
 func (self *LinkedList[T]) InsertNode(node *LinkedListNode[T], index int) {
-	if self.IsEmpty() && index == 0 {
-		self.head = node
-	} else if index == 0 {
-		node.next = self.head
-		self.head.prev = node
-		self.head = node
-	} else {
-		prev := self.NodeAt(index - 1)
-		next := prev.next
-		node.prev = prev
-		node.next = next
-		next.prev = node
+	if index == self.count {
+		self.AppendNode(node)
+		return
+	}
+
+	self.insertBefore(node, self.NodeAt(index))
+}
+
+/*
+Links node into the list in front of next, which must belong to the list.
+*/
+func (self *LinkedList[T]) insertBefore(node *LinkedListNode[T], next *LinkedListNode[T]) {
+	prev := next.prev
+	node.prev = prev
+	node.next = next
+	next.prev = node
+	if prev != nil {
 		prev.next = node
+	} else {
+		self.head = node
 	}
+	self.count += 1
 }
 
 /*
@@ -156,25 +167,62 @@ func (self *LinkedList[T]) InsertValue(value T, index int) {
 Method to insert a copy of a LinkedList at a specific index. Crashes if index is out of bounds (0...self.Count).
 */
 func (self *LinkedList[T]) InsertList(list *LinkedList[T], index int) {
-	if self.IsEmpty() {
+	if index < 0 || index > self.count {
+		panic("index is out of bounds")
+	}
+	self.SpliceList(list.Copy(), index)
+}
+
+/*
+Method to move the nodes of a LinkedList to a specific index, taking ownership
+of them: the nodes are relinked rather than copied, and list is left empty.
+Crashes if index is out of bounds (0...self.Count) or list is the list itself.
+Performance: O(1) to splice, after the O(index) walk to find the position.
+*/
+func (self *LinkedList[T]) SpliceList(list *LinkedList[T], index int) {
+	if index < 0 || index > self.count {
+		panic("index is out of bounds")
+	}
+	if list == self {
+		panic("cannot splice a list into itself")
+	}
+	if list.IsEmpty() {
 		return
 	}
 
-	if index == 0 {
-		list.Tail().next = self.head
-		self.head = list.head
+	var prev, next *LinkedListNode[T]
+	if index == self.count {
+		prev = self.tail
 	} else {
-		prev := self.NodeAt(index - 1)
-		next := prev.next
+		next = self.NodeAt(index)
+		prev = next.prev
+	}
 
+	list.head.prev = prev
+	if prev != nil {
 		prev.next = list.head
-		list.head.prev = prev
+	} else {
+		self.head = list.head
+	}
+	list.tail.next = next
+	if next != nil {
+		next.prev = list.tail
+	} else {
+		self.tail = list.tail
+	}
+	self.count += list.count
+	list.RemoveAll()
+}
 
-		list.Tail().next = next
-		if next != nil {
-			next.prev = list.Tail()
-		}
+/*
+Method to return a copy of the list.
+*/
+func (self *LinkedList[T]) Copy() *LinkedList[T] {
+	result := &LinkedList[T]{}
+	for node := self.head; node != nil; node = node.next {
+		result.AppendValue(node.value)
 	}
+	return result
 }
 
 /*
@@ -182,10 +230,12 @@ Method to remove all nodes/value from the list.
 */
 func (self *LinkedList[T]) RemoveAll() {
 	self.head = nil
+	self.tail = nil
+	self.count = 0
 }
 
 /*
-Method to remove a specific node.
+Method to remove a specific node. The node must belong to the list.
 */
 func (self *LinkedList[T]) RemoveNode(node *LinkedListNode[T]) T {
 	prev := node.prev
@@ -198,21 +248,24 @@ func (self *LinkedList[T]) RemoveNode(node *LinkedListNode[T]) T {
 	}
 	if next != nil {
 		next.prev = prev
+	} else {
+		self.tail = prev
 	}
 
 	node.prev = nil
 	node.next = nil
+	self.count -= 1
 	return node.value
 }
 
 /*
-Method to remove the last node/value in the list. Crashes if the list is empty.
+Method to remove the last node/value in the list. Crashes if the list is empty. Performance: O(1).
 */
 func (self *LinkedList[T]) RemoveLast() T {
 	if self.IsEmpty() {
 		panic("List is empty")
 	}
-	return self.RemoveNode(self.Tail())
+	return self.RemoveNode(self.tail)
 }
 
 /*
@@ -227,6 +280,7 @@ func (self *LinkedList[T]) RemoveAt(index int) T {
 Method to Reverse list.
 */
 func (self *LinkedList[T]) Reverse() {
+	self.tail = self.head
 	for node := self.head; node != nil; {
 		currentNode := node
 		node = currentNode.next
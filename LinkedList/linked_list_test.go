@@ -305,3 +305,149 @@ func TestReverseLinkedList(t *testing.T) {
 	AssertTrue(Tail == list.head, t)
 	AssertEqual(nodeCount, list.Count(), t)
 }
+
+/*
+Walks the list in both directions and checks that the links, the tail and the count agree.
+*/
+func assertConsistent[T any](list *LinkedList[T], t *testing.T) {
+	count := 0
+	var last *LinkedListNode[T]
+	for node := list.head; node != nil; node = node.next {
+		AssertTrue(node.prev == last, t)
+		last = node
+		count += 1
+	}
+	AssertTrue(list.tail == last, t)
+	AssertEqual(list.count, count, t)
+}
+
+func TestAppendKeepsTailAndCount(t *testing.T) {
+	list := &LinkedList[int]{}
+	for i := 0; i < 1000; i++ {
+		list.AppendValue(i)
+		AssertEqual(list.Tail().value, i, t)
+	}
+	AssertEqual(list.Count(), 1000, t)
+	assertConsistent(list, t)
+
+	for i := 999; i >= 500; i-- {
+		AssertEqual(list.RemoveLast(), i, t)
+	}
+	AssertEqual(list.Count(), 500, t)
+	AssertEqual(list.Tail().value, 499, t)
+	assertConsistent(list, t)
+}
+
+func TestNodeAtFromBothEnds(t *testing.T) {
+	numbers := []int{8, 2, 10, 9, 7, 5, 1}
+	list := buildList(numbers)
+	for i, number := range numbers {
+		AssertEqual(list.NodeAt(i).value, number, t)
+	}
+}
+
+func TestInsertValueAtEnd(t *testing.T) {
+	list := buildList([]int{1, 2})
+	list.InsertValue(3, 2)
+	AssertEqual(list.Tail().value, 3, t)
+	assertConsistent(list, t)
+}
+
+func TestInsertListKeepsSource(t *testing.T) {
+	list := buildList([]int{1, 2, 5})
+	list2 := buildList([]int{3, 4})
+	list.InsertList(list2, 2)
+
+	AssertEqual(list.Count(), 5, t)
+	AssertEqual(list.NodeAt(2).value, 3, t)
+	AssertEqual(list.NodeAt(3).value, 4, t)
+	AssertTrue(list.NodeAt(2) != list2.head, t)
+	assertConsistent(list, t)
+
+	AssertEqual(list2.Count(), 2, t)
+	AssertEqual(list2.head.value, 3, t)
+	AssertEqual(list2.Tail().value, 4, t)
+	assertConsistent(list2, t)
+}
+
+func TestAppendListKeepsSource(t *testing.T) {
+	list := buildList([]int{1})
+	list2 := buildList([]int{2, 3})
+	list.AppendList(list2)
+	list2.AppendValue(4)
+	AssertEqual(list.Count(), 3, t)
+	AssertEqual(list.Tail().value, 3, t)
+	AssertEqual(list2.Count(), 3, t)
+	assertConsistent(list, t)
+	assertConsistent(list2, t)
+}
+
+func TestSpliceListTransfersNodes(t *testing.T) {
+	list := buildList([]int{1, 2, 5})
+	list2 := buildList([]int{3, 4})
+	head2, tail2 := list2.head, list2.tail
+	list.SpliceList(list2, 2)
+
+	AssertTrue(list2.IsEmpty(), t)
+	AssertEqual(list2.Count(), 0, t)
+	AssertNil(list2.Tail(), t)
+
+	AssertTrue(list.NodeAt(2) == head2, t)
+	AssertTrue(list.NodeAt(3) == tail2, t)
+	AssertEqual(list.Count(), 5, t)
+	assertConsistent(list, t)
+}
+
+func TestSpliceListIntoEmptyList(t *testing.T) {
+	list := &LinkedList[int]{}
+	list.SpliceList(buildList([]int{1, 2}), 0)
+	AssertEqual(list.Count(), 2, t)
+	AssertEqual(list.Tail().value, 2, t)
+	assertConsistent(list, t)
+}
+
+func TestMoveListUpdatesTail(t *testing.T) {
+	list := buildList([]int{1, 2})
+	list2 := buildList([]int{3, 4})
+	list.MoveList(list2)
+	AssertTrue(list2.IsEmpty(), t)
+	AssertEqual(list.Tail().value, 4, t)
+	list.AppendValue(5)
+	AssertEqual(list.NodeAt(4).value, 5, t)
+	assertConsistent(list, t)
+}
+
+func TestAppendListToItself(t *testing.T) {
+	list := buildList([]int{1, 2})
+	list.AppendList(list)
+	AssertEqual(list.Count(), 4, t)
+	AssertEqual(list.NodeAt(2).value, 1, t)
+	assertConsistent(list, t)
+}
+
+func TestSpliceListIntoItselfCrashes(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	list := buildList([]int{1, 2})
+	list.SpliceList(list, 0)
+}
+
+func TestRemoveNodeUpdatesTail(t *testing.T) {
+	list := buildList([]int{1, 2, 3})
+	list.RemoveNode(list.Tail())
+	AssertEqual(list.Tail().value, 2, t)
+	list.RemoveAt(0)
+	AssertEqual(list.head.value, 2, t)
+	AssertTrue(list.head == list.Tail(), t)
+	assertConsistent(list, t)
+}
+
+func TestReverseUpdatesTail(t *testing.T) {
+	list := buildList([]int{1, 2, 3})
+	list.Reverse()
+	AssertEqual(list.Tail().value, 1, t)
+	list.AppendValue(0)
+	AssertEqual(list.NodeAt(3).value, 0, t)
+	assertConsistent(list, t)
+}
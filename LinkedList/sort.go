@@ -0,0 +1,116 @@
+package linkedlist
+
+/*
+Method to sort the list with a bottom-up merge sort. The sort is stable, so
+nodes that compare equal keep their order, and it relinks the existing nodes
+instead of allocating: first runs of 1 node are merged into sorted runs of 2,
+then runs of 2 into runs of 4, and so on. Only the next pointers are followed
+while merging; the prev pointers and the tail are fixed in a final pass.
+Performance: O(n log n) time, O(1) extra space.
+*/
+func (self *LinkedList[T]) Sort(less func(a, b T) bool) {
+	if self.count < 2 {
+		return
+	}
+
+	for width := 1; width < self.count; width *= 2 {
+		var head, tail *LinkedListNode[T]
+		for remaining := self.head; remaining != nil; {
+			left := remaining
+			right := cut(left, width)
+			remaining = cut(right, width)
+
+			runHead, runTail := merge(left, right, less)
+			if head == nil {
+				head = runHead
+			} else {
+				tail.next = runHead
+			}
+			tail = runTail
+		}
+		self.head = head
+	}
+	self.relink()
+}
+
+/*
+Method to merge another sorted list into this sorted list, keeping it sorted.
+The nodes of other are moved rather than copied, so other is left empty. Of
+nodes that compare equal, the ones from this list come first.
+Performance: O(n + m).
+*/
+func (self *LinkedList[T]) Merge(other *LinkedList[T], less func(a, b T) bool) {
+	if other == self {
+		other = self.Copy()
+	}
+	if other.IsEmpty() {
+		return
+	}
+
+	self.head, _ = merge(self.head, other.head, less)
+	self.count += other.count
+	other.RemoveAll()
+	self.relink()
+}
+
+/*
+Detaches the nodes after the first count nodes starting at node, and returns
+the first of them (or nil if there are none).
+*/
+func cut[T any](node *LinkedListNode[T], count int) *LinkedListNode[T] {
+	for i := 1; node != nil && i < count; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+/*
+Merges two sorted chains of next pointers, taking from left unless right is
+strictly less. Returns the first and last node of the merged chain.
+*/
+func merge[T any](left, right *LinkedListNode[T], less func(a, b T) bool) (*LinkedListNode[T], *LinkedListNode[T]) {
+	var head, tail *LinkedListNode[T]
+	link := func(node *LinkedListNode[T]) {
+		if head == nil {
+			head = node
+		} else {
+			tail.next = node
+		}
+		tail = node
+	}
+	for left != nil && right != nil {
+		if less(right.value, left.value) {
+			link(right)
+			right = right.next
+		} else {
+			link(left)
+			left = left.next
+		}
+	}
+	for _, rest := range [2]*LinkedListNode[T]{left, right} {
+		if rest != nil {
+			link(rest)
+			for tail.next != nil {
+				tail = tail.next
+			}
+		}
+	}
+	return head, tail
+}
+
+/*
+Sets the prev pointers and the tail from the next pointers, starting at the head.
+*/
+func (self *LinkedList[T]) relink() {
+	var prev *LinkedListNode[T]
+	for node := self.head; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+	self.tail = prev
+}
@@ -0,0 +1,106 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+type record struct {
+	key   int
+	order int
+}
+
+func TestSortEmptyAndSingle(t *testing.T) {
+	list := &LinkedList[int]{}
+	list.Sort(LessThan[int])
+	AssertTrue(list.IsEmpty(), t)
+
+	list.AppendValue(1)
+	list.Sort(LessThan[int])
+	AssertEqualSlice(values(list), []int{1}, t)
+	assertConsistent(list, t)
+}
+
+func TestSort(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, size := range []int{2, 3, 7, 8, 9, 100, 1023} {
+		numbers := make([]int, size)
+		for i := range numbers {
+			numbers[i] = random.Intn(50)
+		}
+		list := buildList(numbers)
+		list.Sort(LessThan[int])
+		sort.Ints(numbers)
+		AssertEqualSlice(values(list), numbers, t)
+		assertConsistent(list, t)
+	}
+}
+
+func TestSortKeepsNodes(t *testing.T) {
+	list := buildList([]int{3, 1, 2})
+	nodes := map[*LinkedListNode[int]]bool{}
+	for cursor := list.Front(); cursor.Valid(); cursor.Next() {
+		nodes[cursor.Node()] = true
+	}
+	list.Sort(LessThan[int])
+	for cursor := list.Front(); cursor.Valid(); cursor.Next() {
+		AssertTrue(nodes[cursor.Node()], t)
+	}
+}
+
+func TestSortIsStable(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+	list := &LinkedList[record]{}
+	for i := 0; i < 500; i++ {
+		list.AppendValue(record{key: random.Intn(10), order: i})
+	}
+	list.Sort(func(a, b record) bool { return a.key < b.key })
+	records := values(list)
+	for i := 1; i < len(records); i++ {
+		previous, current := records[i-1], records[i]
+		AssertTrue(previous.key < current.key || (previous.key == current.key && previous.order < current.order), t)
+	}
+	assertConsistent(list, t)
+}
+
+func TestMerge(t *testing.T) {
+	list := buildList([]int{1, 4, 6, 9})
+	other := buildList([]int{2, 3, 7, 10, 11})
+	list.Merge(other, LessThan[int])
+	AssertEqualSlice(values(list), []int{1, 2, 3, 4, 6, 7, 9, 10, 11}, t)
+	AssertEqual(list.Count(), 9, t)
+	AssertTrue(other.IsEmpty(), t)
+	assertConsistent(list, t)
+	assertConsistent(other, t)
+}
+
+func TestMergeIntoEmptyList(t *testing.T) {
+	list := &LinkedList[int]{}
+	list.Merge(buildList([]int{1, 2}), LessThan[int])
+	AssertEqualSlice(values(list), []int{1, 2}, t)
+	assertConsistent(list, t)
+
+	list.Merge(&LinkedList[int]{}, LessThan[int])
+	AssertEqualSlice(values(list), []int{1, 2}, t)
+}
+
+func TestMergeIsStable(t *testing.T) {
+	list := &LinkedList[record]{}
+	other := &LinkedList[record]{}
+	list.AppendValue(record{1, 0})
+	list.AppendValue(record{2, 1})
+	other.AppendValue(record{1, 2})
+	other.AppendValue(record{2, 3})
+	list.Merge(other, func(a, b record) bool { return a.key < b.key })
+	AssertEqualSlice(values(list), []record{{1, 0}, {1, 2}, {2, 1}, {2, 3}}, t)
+}
+
+func TestMergeWithItself(t *testing.T) {
+	list := buildList([]int{1, 2})
+	list.Merge(list, LessThan[int])
+	AssertEqualSlice(values(list), []int{1, 1, 2, 2}, t)
+	assertConsistent(list, t)
+}
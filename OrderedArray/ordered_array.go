@@ -0,0 +1,162 @@
+package orderedarray
+
+import (
+	. "github.com/Jcowwell/go-algorithm-club/BinarySearch"
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+/*
+An ordered array. When you add a new item to this array, it is inserted in
+sorted position. Lookups are O(log n); inserts and removals are O(n) because
+the elements after the affected position have to be shifted.
+*/
+type OrderedArray[T constraints.Ordered] struct {
+	slice []T
+}
+
+/*
+Creates an ordered array from a slice. The slice is copied and sorted,
+so its order does not matter.
+*/
+func OrderedArrayInit[T constraints.Ordered](slice []T) *OrderedArray[T] {
+	sorted := append([]T(nil), slice...)
+	slices.Sort(sorted)
+	return &OrderedArray[T]{slice: sorted}
+}
+
+func (self *OrderedArray[T]) IsEmpty() bool {
+	return len(self.slice) == 0
+}
+
+func (self *OrderedArray[T]) Count() int {
+	return len(self.slice)
+}
+
+/*
+Returns the element at a specific index. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *OrderedArray[T]) At(index int) T {
+	return self.slice[index]
+}
+
+/*
+Returns a copy of the elements in sorted order.
+*/
+func (self *OrderedArray[T]) Slice() []T {
+	return append([]T(nil), self.slice...)
+}
+
+/*
+Inserts an element in sorted position and returns the index it was inserted at.
+Equal elements are inserted before the existing ones. Performance: O(n).
+*/
+func (self *OrderedArray[T]) Insert(element T) int {
+	index := LowerBound(self.slice, element)
+	var zero T
+	self.slice = append(self.slice, zero)
+	copy(self.slice[index+1:], self.slice[index:])
+	self.slice[index] = element
+	return index
+}
+
+/*
+Returns the index of the first occurrence of an element. Performance: O(log n).
+*/
+func (self *OrderedArray[T]) IndexOf(element T) (int, bool) {
+	if index := LowerBound(self.slice, element); index < len(self.slice) && self.slice[index] == element {
+		return index, true
+	}
+	return -1, false
+}
+
+/*
+Returns whether the array contains an element. Performance: O(log n).
+*/
+func (self *OrderedArray[T]) Contains(element T) bool {
+	_, found := self.IndexOf(element)
+	return found
+}
+
+/*
+Removes the first occurrence of an element and returns the index it was removed from.
+*/
+func (self *OrderedArray[T]) Remove(element T) (int, bool) {
+	index, found := self.IndexOf(element)
+	if found {
+		self.RemoveAt(index)
+	}
+	return index, found
+}
+
+/*
+Removes the element at a specific index. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *OrderedArray[T]) RemoveAt(index int) T {
+	element := self.slice[index]
+	copy(self.slice[index:], self.slice[index+1:])
+	var zero T
+	self.slice[len(self.slice)-1] = zero
+	self.slice = self.slice[:len(self.slice)-1]
+	return element
+}
+
+func (self *OrderedArray[T]) RemoveAll() {
+	self.slice = nil
+}
+
+/*
+Returns the elements e with lo <= e < hi, in sorted order. Performance: O(log n + k).
+*/
+func (self *OrderedArray[T]) Range(lo, hi T) []T {
+	if !(lo < hi) {
+		return nil
+	}
+	start := LowerBound(self.slice, lo)
+	end := LowerBound(self.slice, hi)
+	return append([]T(nil), self.slice[start:end]...)
+}
+
+/*
+Returns the smallest element. Performance: O(1).
+*/
+func (self *OrderedArray[T]) Min() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.slice[0], true
+}
+
+/*
+Returns the largest element. Performance: O(1).
+*/
+func (self *OrderedArray[T]) Max() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.slice[len(self.slice)-1], true
+}
+
+/*
+Returns a new ordered array holding the elements of both arrays.
+Performance: O(n + m), as the two sorted slices are merged rather than re-inserted.
+*/
+func (self *OrderedArray[T]) Merge(other *OrderedArray[T]) *OrderedArray[T] {
+	a, b := self.slice, other.slice
+	merged := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			merged = append(merged, b[j])
+			j++
+		} else {
+			merged = append(merged, a[i])
+			i++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	merged = append(merged, b[j:]...)
+	return &OrderedArray[T]{slice: merged}
+}
@@ -0,0 +1,98 @@
+package orderedarray
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestEmpty(t *testing.T) {
+	array := &OrderedArray[int]{}
+	AssertTrue(array.IsEmpty(), t)
+	AssertEqual(array.Count(), 0, t)
+	_, validMin := array.Min()
+	AssertFalse(validMin, t)
+	_, validMax := array.Max()
+	AssertFalse(validMax, t)
+	_, validIndex := array.IndexOf(1)
+	AssertFalse(validIndex, t)
+	_, validRemove := array.Remove(1)
+	AssertFalse(validRemove, t)
+}
+
+func TestInitSorts(t *testing.T) {
+	input := []int{5, 3, 9, 1, 3}
+	array := OrderedArrayInit(input)
+	AssertEqualSlice(array.Slice(), []int{1, 3, 3, 5, 9}, t)
+	AssertEqualSlice(input, []int{5, 3, 9, 1, 3}, t)
+}
+
+func TestInsert(t *testing.T) {
+	array := &OrderedArray[int]{}
+	AssertEqual(array.Insert(5), 0, t)
+	AssertEqual(array.Insert(1), 0, t)
+	AssertEqual(array.Insert(9), 2, t)
+	AssertEqual(array.Insert(5), 1, t)
+	AssertEqual(array.Insert(7), 3, t)
+	AssertEqualSlice(array.Slice(), []int{1, 5, 5, 7, 9}, t)
+	AssertEqual(array.Count(), 5, t)
+	AssertEqual(array.At(3), 7, t)
+}
+
+func TestIndexOfAndContains(t *testing.T) {
+	array := OrderedArrayInit([]string{"pear", "apple", "fig", "apple"})
+	index, valid := array.IndexOf("apple")
+	AssertTrue(valid, t)
+	AssertEqual(index, 0, t)
+	index, valid = array.IndexOf("pear")
+	AssertTrue(valid, t)
+	AssertEqual(index, 3, t)
+	AssertTrue(array.Contains("fig"), t)
+	AssertFalse(array.Contains("grape"), t)
+}
+
+func TestRemove(t *testing.T) {
+	array := OrderedArrayInit([]int{4, 2, 8, 2, 6})
+	index, valid := array.Remove(2)
+	AssertTrue(valid, t)
+	AssertEqual(index, 0, t)
+	AssertEqualSlice(array.Slice(), []int{2, 4, 6, 8}, t)
+
+	_, valid = array.Remove(5)
+	AssertFalse(valid, t)
+
+	AssertEqual(array.RemoveAt(3), 8, t)
+	AssertEqualSlice(array.Slice(), []int{2, 4, 6}, t)
+
+	array.RemoveAll()
+	AssertTrue(array.IsEmpty(), t)
+}
+
+func TestRange(t *testing.T) {
+	array := OrderedArrayInit([]int{1, 3, 3, 5, 7, 9})
+	AssertEqualSlice(array.Range(3, 7), []int{3, 3, 5}, t)
+	AssertEqualSlice(array.Range(0, 100), []int{1, 3, 3, 5, 7, 9}, t)
+	AssertEqual(len(array.Range(4, 5)), 0, t)
+	AssertEqual(len(array.Range(7, 3)), 0, t)
+}
+
+func TestMinMax(t *testing.T) {
+	array := OrderedArrayInit([]float64{2.5, -1, 8})
+	min, _ := array.Min()
+	max, _ := array.Max()
+	AssertEqual(min, -1.0, t)
+	AssertEqual(max, 8.0, t)
+}
+
+func TestMerge(t *testing.T) {
+	a := OrderedArrayInit([]int{1, 4, 4, 10})
+	b := OrderedArrayInit([]int{0, 4, 5, 11, 12})
+	merged := a.Merge(b)
+	AssertEqualSlice(merged.Slice(), []int{0, 1, 4, 4, 4, 5, 10, 11, 12}, t)
+	AssertEqual(a.Count(), 4, t)
+	AssertEqual(b.Count(), 5, t)
+
+	empty := &OrderedArray[int]{}
+	AssertEqualSlice(empty.Merge(a).Slice(), a.Slice(), t)
+	AssertEqualSlice(a.Merge(empty).Slice(), a.Slice(), t)
+}
@@ -0,0 +1,113 @@
+package queue
+
+import (
+	. "github.com/Jcowwell/go-algorithm-club/Stack"
+)
+
+type aggregateEntry[T comparable] struct {
+	element   T
+	aggregate T // The aggregate of this element and every element below it on its stack.
+}
+
+/*
+First-in first-out queue (FIFO) that maintains the aggregate of its elements
+under any associative operation, such as a sum, a product, a minimum or a GCD.
+This is the two-stack "sliding window aggregation" (SWAG) technique.
+
+Elements are pushed onto a back stack and popped from a front stack. Every
+entry also records the aggregate of itself and everything below it, so the
+aggregate of the whole queue combines just the two tops. When the front stack
+runs out, the back stack is flipped onto it, recomputing the aggregates. Each
+element is flipped once, so Enqueue, Dequeue and Aggregate are amortized O(1).
+
+The operation does not need to be commutative: elements are always combined
+from oldest to newest.
+*/
+type AggregateQueue[T comparable] struct {
+	front     Stack[aggregateEntry[T]] // Oldest element on top. Aggregates run from the entry to the newest below it.
+	back      Stack[aggregateEntry[T]] // Newest element on top. Aggregates run from the oldest below it to the entry.
+	operation func(T, T) T
+}
+
+/*
+Creates an empty queue that aggregates its elements with operation, which must be associative.
+*/
+func AggregateQueueInit[T comparable](operation func(T, T) T) *AggregateQueue[T] {
+	return &AggregateQueue[T]{operation: operation}
+}
+
+func (self *AggregateQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+func (self *AggregateQueue[T]) Count() int {
+	return self.front.Count() + self.back.Count()
+}
+
+/*
+Adds an element to the back of the queue. Performance: O(1).
+*/
+func (self *AggregateQueue[T]) Enqueue(element T) {
+	aggregate := element
+	if top, valid := self.back.Peek(); valid {
+		aggregate = self.operation(top.aggregate, element)
+	}
+	self.back.Push(aggregateEntry[T]{element: element, aggregate: aggregate})
+}
+
+/*
+Removes the element at the front of the queue. Performance: amortized O(1).
+*/
+func (self *AggregateQueue[T]) Dequeue() (T, bool) {
+	self.flip()
+	entry, valid := self.front.Pop()
+	return entry.element, valid
+}
+
+/*
+Returns the element at the front of the queue. Performance: amortized O(1).
+*/
+func (self *AggregateQueue[T]) Peek() (T, bool) {
+	self.flip()
+	entry, valid := self.front.Peek()
+	return entry.element, valid
+}
+
+/*
+Returns the aggregate of every element in the queue, from oldest to newest.
+Returns false if the queue is empty. Performance: O(1).
+*/
+func (self *AggregateQueue[T]) Aggregate() (T, bool) {
+	front, validFront := self.front.Peek()
+	back, validBack := self.back.Peek()
+	switch {
+	case validFront && validBack:
+		return self.operation(front.aggregate, back.aggregate), true
+	case validFront:
+		return front.aggregate, true
+	case validBack:
+		return back.aggregate, true
+	}
+	var aggregate T
+	return aggregate, false
+}
+
+/*
+Moves every element of the back stack onto the front stack if the front stack is empty.
+*/
+func (self *AggregateQueue[T]) flip() {
+	if !self.front.IsEmpty() {
+		return
+	}
+	for {
+		entry, valid := self.back.Pop()
+		if !valid {
+			return
+		}
+		aggregate := entry.element
+		if top, valid := self.front.Peek(); valid {
+			aggregate = self.operation(entry.element, top.aggregate)
+		}
+		self.front.Push(aggregateEntry[T]{element: entry.element, aggregate: aggregate})
+	}
+}
@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func TestAggregateQueueEmpty(t *testing.T) {
+	queue := AggregateQueueInit(func(a, b int) int { return a + b })
+	AssertTrue(queue.IsEmpty(), t)
+	_, validAggregate := queue.Aggregate()
+	AssertFalse(validAggregate, t)
+	_, validPeek := queue.Peek()
+	AssertFalse(validPeek, t)
+	_, validDequeue := queue.Dequeue()
+	AssertFalse(validDequeue, t)
+}
+
+func TestAggregateQueueSum(t *testing.T) {
+	queue := AggregateQueueInit(func(a, b int) int { return a + b })
+	for i := 1; i <= 4; i++ {
+		queue.Enqueue(i)
+	}
+	sum, _ := queue.Aggregate()
+	AssertEqual(sum, 10, t)
+
+	value, _ := queue.Dequeue()
+	AssertEqual(value, 1, t)
+	sum, _ = queue.Aggregate()
+	AssertEqual(sum, 9, t)
+
+	queue.Enqueue(10)
+	AssertEqual(queue.Count(), 4, t)
+	sum, _ = queue.Aggregate()
+	AssertEqual(sum, 19, t)
+	peek, _ := queue.Peek()
+	AssertEqual(peek, 2, t)
+}
+
+func TestAggregateQueueNonCommutative(t *testing.T) {
+	queue := AggregateQueueInit(func(a, b string) string { return a + b })
+	for _, s := range []string{"a", "b", "c"} {
+		queue.Enqueue(s)
+	}
+	queue.Dequeue()
+	queue.Enqueue("d")
+	queue.Enqueue("e")
+	concatenation, _ := queue.Aggregate()
+	AssertEqual(concatenation, "bcde", t)
+}
+
+func TestAggregateQueueSlidingGCD(t *testing.T) {
+	random := rand.New(rand.NewSource(9))
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = 6 * (1 + random.Intn(20))
+	}
+	const window = 5
+	queue := AggregateQueueInit(gcd)
+	for i, value := range values {
+		queue.Enqueue(value)
+		if queue.Count() > window {
+			queue.Dequeue()
+		}
+		expected := 0
+		for _, v := range values[Max(0, i-window+1) : i+1] {
+			expected = gcd(expected, v)
+		}
+		aggregate, _ := queue.Aggregate()
+		if aggregate != expected {
+			t.Fatalf("index %d: expected %d got %d", i, expected, aggregate)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned when enqueuing to a closed BlockingQueue, or when dequeuing from
+// one that has been closed and emptied.
+var ErrClosed = errors.New("queue: closed")
+
+/*
+First-in first-out queue (FIFO) that is safe for concurrent use and lets
+consumers wait for elements, making it usable as a producer/consumer work queue.
+
+Closing the queue stops new elements from being added, but the elements
+already queued can still be dequeued. Once they are gone, dequeuing reports
+ErrClosed, which signals the end of the stream. The zero value is an empty,
+open queue.
+*/
+type BlockingQueue[T comparable] struct {
+	mutex  sync.Mutex
+	queue  Queue[T]
+	closed bool
+	ready  chan struct{} // Closed to wake waiting consumers. Only allocated while someone waits.
+}
+
+func (self *BlockingQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+func (self *BlockingQueue[T]) Count() int {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.queue.Count()
+}
+
+func (self *BlockingQueue[T]) IsClosed() bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.closed
+}
+
+/*
+Adds an element to the back of the queue and wakes any waiting consumers.
+Returns ErrClosed if the queue has been closed.
+*/
+func (self *BlockingQueue[T]) Enqueue(element T) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.closed {
+		return ErrClosed
+	}
+	self.queue.Enqueue(element)
+	self.wake()
+	return nil
+}
+
+/*
+Removes the element at the front of the queue without waiting.
+Returns false if the queue is empty.
+*/
+func (self *BlockingQueue[T]) Dequeue() (T, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.queue.Dequeue()
+}
+
+/*
+Removes the element at the front of the queue, waiting until one is available.
+Returns ErrClosed once the queue is closed and empty, or the context's error
+if it is done before an element arrives.
+*/
+func (self *BlockingQueue[T]) DequeueContext(ctx context.Context) (T, error) {
+	for {
+		self.mutex.Lock()
+		if element, valid := self.queue.Dequeue(); valid {
+			self.mutex.Unlock()
+			return element, nil
+		}
+		if self.closed {
+			self.mutex.Unlock()
+			var element T
+			return element, ErrClosed
+		}
+		if self.ready == nil {
+			self.ready = make(chan struct{})
+		}
+		ready := self.ready
+		self.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			var element T
+			return element, ctx.Err()
+		case <-ready:
+		}
+	}
+}
+
+/*
+Removes every element currently in the queue without waiting, and returns
+them in order. The queue stays open unless it was already closed.
+*/
+func (self *BlockingQueue[T]) Drain() []T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	elements := make([]T, 0, self.queue.Count())
+	for {
+		element, valid := self.queue.Dequeue()
+		if !valid {
+			return elements
+		}
+		elements = append(elements, element)
+	}
+}
+
+/*
+Closes the queue. Further calls to Enqueue fail, and consumers waiting on an
+empty queue receive ErrClosed. Closing an already closed queue does nothing.
+*/
+func (self *BlockingQueue[T]) Close() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if !self.closed {
+		self.closed = true
+		self.wake()
+	}
+}
+
+/*
+Wakes every waiting consumer. The mutex must be held.
+*/
+func (self *BlockingQueue[T]) wake() {
+	if self.ready != nil {
+		close(self.ready)
+		self.ready = nil
+	}
+}
@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestBlockingQueueNonBlocking(t *testing.T) {
+	queue := BlockingQueue[int]{}
+	AssertTrue(queue.IsEmpty(), t)
+	_, valid := queue.Dequeue()
+	AssertFalse(valid, t)
+
+	AssertTrue(queue.Enqueue(1) == nil, t)
+	AssertTrue(queue.Enqueue(2) == nil, t)
+	AssertEqual(queue.Count(), 2, t)
+	value, valid := queue.Dequeue()
+	AssertTrue(valid, t)
+	AssertEqual(value, 1, t)
+}
+
+func TestBlockingQueueDequeueWaits(t *testing.T) {
+	queue := BlockingQueue[string]{}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Enqueue("hello")
+	}()
+	value, err := queue.DequeueContext(context.Background())
+	AssertTrue(err == nil, t)
+	AssertEqual(value, "hello", t)
+}
+
+func TestBlockingQueueDequeueCancelled(t *testing.T) {
+	queue := BlockingQueue[int]{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := queue.DequeueContext(ctx)
+	AssertTrue(err == context.DeadlineExceeded, t)
+
+	// The queue still works after a cancelled wait.
+	queue.Enqueue(7)
+	value, err := queue.DequeueContext(context.Background())
+	AssertTrue(err == nil, t)
+	AssertEqual(value, 7, t)
+}
+
+func TestBlockingQueueCloseDrainsThenEnds(t *testing.T) {
+	queue := BlockingQueue[int]{}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Close()
+	queue.Close()
+	AssertTrue(queue.IsClosed(), t)
+	AssertTrue(queue.Enqueue(3) == ErrClosed, t)
+
+	value, err := queue.DequeueContext(context.Background())
+	AssertTrue(err == nil, t)
+	AssertEqual(value, 1, t)
+	value, err = queue.DequeueContext(context.Background())
+	AssertTrue(err == nil, t)
+	AssertEqual(value, 2, t)
+	_, err = queue.DequeueContext(context.Background())
+	AssertTrue(err == ErrClosed, t)
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	queue := BlockingQueue[int]{}
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := queue.DequeueContext(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	for i := 0; i < 3; i++ {
+		AssertTrue(<-errs == ErrClosed, t)
+	}
+}
+
+func TestBlockingQueueDrain(t *testing.T) {
+	queue := BlockingQueue[int]{}
+	AssertEqual(len(queue.Drain()), 0, t)
+	for i := 1; i <= 4; i++ {
+		queue.Enqueue(i)
+	}
+	AssertEqualSlice(queue.Drain(), []int{1, 2, 3, 4}, t)
+	AssertTrue(queue.IsEmpty(), t)
+	AssertFalse(queue.IsClosed(), t)
+}
+
+func TestBlockingQueueWorkers(t *testing.T) {
+	const producers, workers, perProducer = 4, 4, 2500
+	queue := BlockingQueue[int]{}
+
+	var producerGroup sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerGroup.Add(1)
+		go func() {
+			defer producerGroup.Done()
+			for i := 1; i <= perProducer; i++ {
+				queue.Enqueue(i)
+			}
+		}()
+	}
+
+	sums := make(chan int, workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			sum := 0
+			for {
+				value, err := queue.DequeueContext(context.Background())
+				if err != nil {
+					sums <- sum
+					return
+				}
+				sum += value
+			}
+		}()
+	}
+
+	producerGroup.Wait()
+	queue.Close()
+	total := 0
+	for w := 0; w < workers; w++ {
+		total += <-sums
+	}
+	AssertEqual(total, producers*perProducer*(perProducer+1)/2, t)
+}
@@ -0,0 +1,169 @@
+package queue
+
+import (
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+type monotonicEntry[T any] struct {
+	element T
+	index   int // The position of the element in the order it was enqueued.
+}
+
+/*
+First-in first-out queue (FIFO) that reports its "best" element in O(1), where
+the sort function decides which of two elements is better: for comparable data
+types, > tracks the maximum and < tracks the minimum.
+
+It only stores the elements that can still become the best one: an element is
+discarded as soon as a better one is enqueued after it, since it will leave the
+queue first. What remains is monotonic from front to back, and the front is the
+best element. Enqueue and Dequeue are amortized O(1) operations.
+*/
+type MonotonicQueue[T any] struct {
+	slice         []monotonicEntry[T]
+	head          int
+	enqueued      int             // The number of elements ever enqueued.
+	dequeued      int             // The number of elements ever dequeued.
+	orderCriteria func(T, T) bool // Reports whether the first element is better than the second.
+}
+
+/*
+To track the maximum supply a GreaterThan sort function. To track the minimum,
+use the LessThan sort function.
+*/
+func MonotonicQueueInit[T any](sort func(T, T) bool) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{orderCriteria: sort}
+}
+
+func (self *MonotonicQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+/*
+Returns the number of elements that have been enqueued and not yet dequeued,
+including the ones that were discarded.
+*/
+func (self *MonotonicQueue[T]) Count() int {
+	return self.enqueued - self.dequeued
+}
+
+func (self *MonotonicQueue[T]) Enqueue(element T) {
+	for len(self.slice) > self.head && self.orderCriteria(element, self.slice[len(self.slice)-1].element) {
+		self.slice = self.slice[:len(self.slice)-1]
+	}
+	self.slice = append(self.slice, monotonicEntry[T]{element: element, index: self.enqueued})
+	self.enqueued += 1
+}
+
+/*
+Removes the oldest element from the queue. Returns false if the queue is empty.
+The oldest element may already have been discarded, so it isn't returned.
+*/
+func (self *MonotonicQueue[T]) Dequeue() bool {
+	if self.IsEmpty() {
+		return false
+	}
+	if self.slice[self.head].index == self.dequeued {
+		self.slice[self.head] = monotonicEntry[T]{}
+		self.head += 1
+
+		percentage := float64(self.head) / float64(len(self.slice))
+		if len(self.slice) > 50 && percentage > 0.25 {
+			self.slice = self.slice[self.head:]
+			self.head = 0
+		}
+	}
+	self.dequeued += 1
+	return true
+}
+
+/*
+Returns the best element in the queue.
+*/
+func (self *MonotonicQueue[T]) Peek() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.slice[self.head].element, true
+}
+
+/*
+Returns the maximum of every window of size consecutive values, in O(n) total time.
+The result has len(values)-size+1 elements, or none if there are fewer than size values.
+Crashes if size is not positive.
+*/
+func SlidingWindowMax[N Numeric](values []N, size int) []N {
+	return slidingWindow(values, size, GreaterThan[N])
+}
+
+/*
+Returns the minimum of every window of size consecutive values, in O(n) total time.
+The result has len(values)-size+1 elements, or none if there are fewer than size values.
+Crashes if size is not positive.
+*/
+func SlidingWindowMin[N Numeric](values []N, size int) []N {
+	return slidingWindow(values, size, LessThan[N])
+}
+
+/*
+Streams the maximum of every window of size consecutive values received from
+values. The returned channel is closed once values is closed. Crashes if size
+is not positive.
+*/
+func SlidingWindowMaxStream[N Numeric](values <-chan N, size int) <-chan N {
+	return slidingWindowStream(values, size, GreaterThan[N])
+}
+
+/*
+Streams the minimum of every window of size consecutive values received from
+values. The returned channel is closed once values is closed. Crashes if size
+is not positive.
+*/
+func SlidingWindowMinStream[N Numeric](values <-chan N, size int) <-chan N {
+	return slidingWindowStream(values, size, LessThan[N])
+}
+
+func slidingWindow[N Numeric](values []N, size int, sort func(N, N) bool) []N {
+	if size <= 0 {
+		panic("window size must be greater than 0")
+	}
+	if len(values) < size {
+		return []N{}
+	}
+	result := make([]N, 0, len(values)-size+1)
+	window := MonotonicQueueInit(sort)
+	for _, value := range values {
+		window.Enqueue(value)
+		if window.Count() > size {
+			window.Dequeue()
+		}
+		if window.Count() == size {
+			best, _ := window.Peek()
+			result = append(result, best)
+		}
+	}
+	return result
+}
+
+func slidingWindowStream[N Numeric](values <-chan N, size int, sort func(N, N) bool) <-chan N {
+	if size <= 0 {
+		panic("window size must be greater than 0")
+	}
+	result := make(chan N)
+	go func() {
+		defer close(result)
+		window := MonotonicQueueInit(sort)
+		for value := range values {
+			window.Enqueue(value)
+			if window.Count() > size {
+				window.Dequeue()
+			}
+			if window.Count() == size {
+				best, _ := window.Peek()
+				result <- best
+			}
+		}
+	}()
+	return result
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestMonotonicQueueEmpty(t *testing.T) {
+	queue := MonotonicQueueInit(GreaterThan[int])
+	AssertTrue(queue.IsEmpty(), t)
+	_, validPeek := queue.Peek()
+	AssertFalse(validPeek, t)
+	AssertFalse(queue.Dequeue(), t)
+}
+
+func TestMonotonicQueueMax(t *testing.T) {
+	queue := MonotonicQueueInit(GreaterThan[int])
+	for _, value := range []int{3, 1, 4, 1, 5} {
+		queue.Enqueue(value)
+	}
+	AssertEqual(queue.Count(), 5, t)
+	max, _ := queue.Peek()
+	AssertEqual(max, 5, t)
+
+	for i := 0; i < 4; i++ {
+		AssertTrue(queue.Dequeue(), t)
+		max, _ = queue.Peek()
+		AssertEqual(max, 5, t)
+	}
+	AssertTrue(queue.Dequeue(), t)
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+func TestMonotonicQueueDuplicates(t *testing.T) {
+	queue := MonotonicQueueInit(LessThan[int])
+	queue.Enqueue(2)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	queue.Dequeue()
+	min, _ := queue.Peek()
+	AssertEqual(min, 2, t)
+	queue.Dequeue()
+	min, _ = queue.Peek()
+	AssertEqual(min, 3, t)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	values := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	AssertEqualSlice(SlidingWindowMax(values, 3), []int{3, 3, 5, 5, 6, 7}, t)
+	AssertEqualSlice(SlidingWindowMin(values, 3), []int{-1, -3, -3, -3, 3, 3}, t)
+	AssertEqualSlice(SlidingWindowMax(values, 1), values, t)
+	AssertEqualSlice(SlidingWindowMax(values, 8), []int{7}, t)
+	AssertEqual(len(SlidingWindowMin(values, 9)), 0, t)
+}
+
+func TestSlidingWindowAgainstNaive(t *testing.T) {
+	random := rand.New(rand.NewSource(5))
+	values := make([]float64, 2000)
+	for i := range values {
+		values[i] = float64(random.Intn(50))
+	}
+	for _, size := range []int{1, 2, 7, 64, 500} {
+		expectedMax := []float64{}
+		expectedMin := []float64{}
+		for start := 0; start+size <= len(values); start++ {
+			min, max := values[start], values[start]
+			for _, value := range values[start : start+size] {
+				min = Min(min, value)
+				max = Max(max, value)
+			}
+			expectedMax = append(expectedMax, max)
+			expectedMin = append(expectedMin, min)
+		}
+		AssertEqualSlice(SlidingWindowMax(values, size), expectedMax, t)
+		AssertEqualSlice(SlidingWindowMin(values, size), expectedMin, t)
+	}
+}
+
+func TestSlidingWindowStream(t *testing.T) {
+	values := make(chan int)
+	go func() {
+		for _, value := range []int{4, 2, 12, 3, 8, 1} {
+			values <- value
+		}
+		close(values)
+	}()
+	maxima := []int{}
+	for max := range SlidingWindowMaxStream(values, 2) {
+		maxima = append(maxima, max)
+	}
+	AssertEqualSlice(maxima, []int{4, 12, 12, 8, 8}, t)
+}
+
+func TestSlidingWindowInvalidSize(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	SlidingWindowMax([]int{1}, 0)
+}
@@ -0,0 +1,123 @@
+package queue
+
+import "sync/atomic"
+
+/*
+Lock-free bounded queue for any number of producer and consumer goroutines
+(multi-producer/multi-consumer), after Dmitry Vyukov's bounded MPMC queue.
+
+Every cell carries a sequence number. A producer at position pos may fill
+the cell once its sequence equals pos, and a consumer may empty it once its
+sequence equals pos+1. Producers and consumers claim positions with a single
+compare-and-swap, so they only contend with their own kind. Enqueue and
+Dequeue never block.
+
+The sequence numbers are kept in a slice of their own rather than next to the
+elements: the 64-bit atomics need 8-byte alignment, which on 32-bit platforms
+only the elements of a []uint64 are guaranteed to have.
+*/
+type MPMCQueue[T any] struct {
+	_          cacheLinePad
+	enqueuePos uint64
+	_          cacheLinePad
+	dequeuePos uint64
+	_          cacheLinePad
+	mask       uint64
+	sequences  []uint64 // Tells producers and consumers whose turn it is to use each cell.
+	elements   []T
+}
+
+/*
+Creates an empty queue. The capacity is rounded up to a power of two, and to
+at least 2. Crashes if capacity is not positive.
+*/
+func MPMCQueueInit[T any](capacity int) *MPMCQueue[T] {
+	size := powerOfTwo(capacity)
+	if size < 2 {
+		size = 2
+	}
+	sequences := make([]uint64, size)
+	for index := range sequences {
+		sequences[index] = uint64(index)
+	}
+	return &MPMCQueue[T]{mask: size - 1, sequences: sequences, elements: make([]T, size)}
+}
+
+func (self *MPMCQueue[T]) Capacity() int {
+	return len(self.elements)
+}
+
+/*
+Returns the number of elements in the queue. It is only a snapshot when
+called while other goroutines are using the queue.
+*/
+func (self *MPMCQueue[T]) Count() int {
+	dequeuePos := atomic.LoadUint64(&self.dequeuePos)
+	enqueuePos := atomic.LoadUint64(&self.enqueuePos)
+	if enqueuePos < dequeuePos {
+		return 0
+	}
+	return int(enqueuePos - dequeuePos)
+}
+
+func (self *MPMCQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+/*
+Adds an element to the back of the queue. Returns false if the queue is full.
+*/
+func (self *MPMCQueue[T]) Enqueue(element T) bool {
+	var cell uint64
+	pos := atomic.LoadUint64(&self.enqueuePos)
+	for {
+		cell = pos & self.mask
+		sequence := atomic.LoadUint64(&self.sequences[cell])
+		if diff := int64(sequence - pos); diff == 0 {
+			if atomic.CompareAndSwapUint64(&self.enqueuePos, pos, pos+1) {
+				break
+			}
+			pos = atomic.LoadUint64(&self.enqueuePos)
+		} else if diff < 0 {
+			// The cell still holds the element from the previous lap.
+			return false
+		} else {
+			// Another producer claimed pos first.
+			pos = atomic.LoadUint64(&self.enqueuePos)
+		}
+	}
+	self.elements[cell] = element
+	atomic.StoreUint64(&self.sequences[cell], pos+1)
+	return true
+}
+
+/*
+Removes the element at the front of the queue. Returns false if the queue is empty.
+*/
+func (self *MPMCQueue[T]) Dequeue() (T, bool) {
+	var cell uint64
+	pos := atomic.LoadUint64(&self.dequeuePos)
+	for {
+		cell = pos & self.mask
+		sequence := atomic.LoadUint64(&self.sequences[cell])
+		if diff := int64(sequence - (pos + 1)); diff == 0 {
+			if atomic.CompareAndSwapUint64(&self.dequeuePos, pos, pos+1) {
+				break
+			}
+			pos = atomic.LoadUint64(&self.dequeuePos)
+		} else if diff < 0 {
+			// No producer has filled the cell yet.
+			var element T
+			return element, false
+		} else {
+			// Another consumer claimed pos first.
+			pos = atomic.LoadUint64(&self.dequeuePos)
+		}
+	}
+	var zero T
+	element := self.elements[cell]
+	self.elements[cell] = zero
+	// Hand the cell to the producer of the next lap.
+	atomic.StoreUint64(&self.sequences[cell], pos+self.mask+1)
+	return element, true
+}
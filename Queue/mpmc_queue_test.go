@@ -0,0 +1,204 @@
+package queue
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestMPMCQueueSequential(t *testing.T) {
+	queue := MPMCQueueInit[string](1)
+	AssertEqual(queue.Capacity(), 2, t)
+	AssertTrue(queue.Enqueue("a"), t)
+	AssertTrue(queue.Enqueue("b"), t)
+	AssertFalse(queue.Enqueue("c"), t)
+	AssertEqual(queue.Count(), 2, t)
+
+	value, _ := queue.Dequeue()
+	AssertEqual(value, "a", t)
+	AssertTrue(queue.Enqueue("c"), t)
+	value, _ = queue.Dequeue()
+	AssertEqual(value, "b", t)
+	value, _ = queue.Dequeue()
+	AssertEqual(value, "c", t)
+	_, valid := queue.Dequeue()
+	AssertFalse(valid, t)
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+func TestMPMCQueueSmallElements(t *testing.T) {
+	// With 4-byte elements a cell holding its own sequence number would leave
+	// every other sequence misaligned for 64-bit atomics on 32-bit platforms.
+	queue := MPMCQueueInit[int32](8)
+	for lap := 0; lap < 3; lap++ {
+		for i := int32(0); i < 8; i++ {
+			AssertTrue(queue.Enqueue(i), t)
+		}
+		for i := int32(0); i < 8; i++ {
+			value, _ := queue.Dequeue()
+			AssertEqual(value, i, t)
+		}
+	}
+}
+
+func TestMPMCQueueStress(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 20000
+	queue := MPMCQueueInit[[2]int](128)
+
+	var producerGroup sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerGroup.Add(1)
+		go func(p int) {
+			defer producerGroup.Done()
+			for i := 0; i < perProducer; {
+				if queue.Enqueue([2]int{p, i}) {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	// Each consumer checks that the items it sees from any one producer arrive in order.
+	received := make([][]int, consumers)
+	var consumerGroup sync.WaitGroup
+	var mutex sync.Mutex
+	remaining := producers * perProducer
+	for c := 0; c < consumers; c++ {
+		consumerGroup.Add(1)
+		go func(c int) {
+			defer consumerGroup.Done()
+			last := []int{-1, -1, -1, -1}
+			counts := make([]int, producers)
+			for {
+				item, valid := queue.Dequeue()
+				if !valid {
+					mutex.Lock()
+					done := remaining == 0
+					mutex.Unlock()
+					if done {
+						break
+					}
+					runtime.Gosched()
+					continue
+				}
+				if item[1] <= last[item[0]] {
+					t.Errorf("producer %d: %d arrived after %d", item[0], item[1], last[item[0]])
+				}
+				last[item[0]] = item[1]
+				counts[item[0]]++
+				mutex.Lock()
+				remaining--
+				mutex.Unlock()
+			}
+			received[c] = counts
+		}(c)
+	}
+
+	producerGroup.Wait()
+	consumerGroup.Wait()
+	for p := 0; p < producers; p++ {
+		total := 0
+		for c := 0; c < consumers; c++ {
+			total += received[c][p]
+		}
+		AssertEqual(total, perProducer, t)
+	}
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+// Benchmarks hand items from one group of goroutines to another through each kind of queue.
+
+type mutexQueue struct {
+	mutex sync.Mutex
+	queue Queue[int]
+}
+
+func (self *mutexQueue) Enqueue(element int) bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.queue.Enqueue(element)
+	return true
+}
+
+func (self *mutexQueue) Dequeue() (int, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.queue.Dequeue()
+}
+
+type channelQueue chan int
+
+func (self channelQueue) Enqueue(element int) bool {
+	select {
+	case self <- element:
+		return true
+	default:
+		return false
+	}
+}
+
+func (self channelQueue) Dequeue() (int, bool) {
+	select {
+	case element := <-self:
+		return element, true
+	default:
+		return 0, false
+	}
+}
+
+type benchmarkQueue interface {
+	Enqueue(int) bool
+	Dequeue() (int, bool)
+}
+
+func benchmarkHandoff(b *testing.B, queue benchmarkQueue, producers, consumers int) {
+	perProducer := b.N/producers + 1
+	remaining := int64(perProducer * producers)
+	var wg sync.WaitGroup
+	b.ResetTimer()
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; {
+				if queue.Enqueue(i) {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt64(&remaining) > 0 {
+				if _, valid := queue.Dequeue(); valid {
+					atomic.AddInt64(&remaining, -1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkSPSCHandoff(b *testing.B) {
+	b.Run("SPSCQueue", func(b *testing.B) { benchmarkHandoff(b, SPSCQueueInit[int](1024), 1, 1) })
+	b.Run("MPMCQueue", func(b *testing.B) { benchmarkHandoff(b, MPMCQueueInit[int](1024), 1, 1) })
+	b.Run("MutexQueue", func(b *testing.B) { benchmarkHandoff(b, &mutexQueue{}, 1, 1) })
+	b.Run("Channel", func(b *testing.B) { benchmarkHandoff(b, make(channelQueue, 1024), 1, 1) })
+}
+
+func BenchmarkMPMCHandoff(b *testing.B) {
+	b.Run("MPMCQueue", func(b *testing.B) { benchmarkHandoff(b, MPMCQueueInit[int](1024), 4, 4) })
+	b.Run("MutexQueue", func(b *testing.B) { benchmarkHandoff(b, &mutexQueue{}, 4, 4) })
+	b.Run("Channel", func(b *testing.B) { benchmarkHandoff(b, make(channelQueue, 1024), 4, 4) })
+}
@@ -0,0 +1,153 @@
+package queue
+
+import (
+	"sync"
+
+	. "github.com/Jcowwell/go-algorithm-club/Stack"
+)
+
+/*
+A lazily evaluated, memoized list. The thunk runs at most once, the first time
+the stream is forced, and every holder of the stream then sees the same cell.
+A nil stream, or one whose thunk returns nil, is empty.
+*/
+type stream[T any] struct {
+	once  sync.Once
+	thunk func() *streamCell[T]
+	cell  *streamCell[T]
+}
+
+type streamCell[T any] struct {
+	head T
+	tail *stream[T]
+}
+
+func (self *stream[T]) force() *streamCell[T] {
+	if self == nil {
+		return nil
+	}
+	self.once.Do(func() {
+		self.cell = self.thunk()
+		self.thunk = nil
+	})
+	return self.cell
+}
+
+/*
+Returns the stream a followed by b. Each cell is only built when it is forced,
+so appending is O(1) and the work is spread over the dequeues that reach it.
+*/
+func appendStreams[T any](a, b *stream[T]) *stream[T] {
+	return &stream[T]{thunk: func() *streamCell[T] {
+		cell := a.force()
+		if cell == nil {
+			return b.force()
+		}
+		return &streamCell[T]{head: cell.head, tail: appendStreams(cell.tail, b)}
+	}}
+}
+
+/*
+Returns a stream of the stack's elements from bottom to top. The whole
+reversal happens when the stream is first forced.
+*/
+func reverseStack[T any](stack PersistentStack[T]) *stream[T] {
+	return &stream[T]{thunk: func() *streamCell[T] {
+		var reversed *stream[T]
+		for {
+			element, rest, valid := stack.Pop()
+			if !valid {
+				return reversed.force()
+			}
+			cell := &streamCell[T]{head: element, tail: reversed}
+			reversed = &stream[T]{thunk: func() *streamCell[T] { return cell }}
+			stack = rest
+		}
+	}}
+}
+
+/*
+Immutable first-in first-out queue (FIFO): Okasaki's banker's queue.
+
+Elements are dequeued from a lazy front stream and enqueued onto a persistent
+rear stack. Whenever the rear grows longer than the front, the queue is
+rotated to front ++ reverse(rear). The rotation is suspended rather than
+performed, and because suspensions are memoized, the cost of each reversal is
+paid at most once no matter how many versions of the queue share it. That
+keeps Enqueue and Dequeue O(1) amortized even when old versions are reused.
+
+Enqueue and Dequeue return a new queue and leave the receiver untouched. The
+zero value is an empty queue, and queues may be shared between goroutines.
+*/
+type PersistentQueue[T any] struct {
+	front      *stream[T]
+	frontCount int
+	rear       PersistentStack[T]
+}
+
+func (self PersistentQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+func (self PersistentQueue[T]) Count() int {
+	return self.frontCount + self.rear.Count()
+}
+
+/*
+Returns a new queue with element at the back. Performance: O(1) amortized.
+*/
+func (self PersistentQueue[T]) Enqueue(element T) PersistentQueue[T] {
+	self.rear = self.rear.Push(element)
+	return self.balance()
+}
+
+/*
+Returns the element at the front and the queue behind it. Performance: O(1) amortized.
+*/
+func (self PersistentQueue[T]) Dequeue() (T, PersistentQueue[T], bool) {
+	cell := self.front.force()
+	if cell == nil {
+		var element T
+		return element, self, false
+	}
+	self.front = cell.tail
+	self.frontCount -= 1
+	return cell.head, self.balance(), true
+}
+
+func (self PersistentQueue[T]) Peek() (T, bool) {
+	cell := self.front.force()
+	if cell == nil {
+		var element T
+		return element, false
+	}
+	return cell.head, true
+}
+
+/*
+Returns the elements from front to back in a new slice.
+*/
+func (self PersistentQueue[T]) Slice() []T {
+	result := make([]T, 0, self.Count())
+	for queue := self; ; {
+		element, rest, valid := queue.Dequeue()
+		if !valid {
+			return result
+		}
+		result = append(result, element)
+		queue = rest
+	}
+}
+
+/*
+Maintains the invariant that the rear is never longer than the front.
+*/
+func (self PersistentQueue[T]) balance() PersistentQueue[T] {
+	if self.rear.Count() <= self.frontCount {
+		return self
+	}
+	return PersistentQueue[T]{
+		front:      appendStreams(self.front, reverseStack(self.rear)),
+		frontCount: self.frontCount + self.rear.Count(),
+	}
+}
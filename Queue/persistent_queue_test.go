@@ -0,0 +1,97 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestPersistentQueueEmpty(t *testing.T) {
+	queue := PersistentQueue[int]{}
+	AssertTrue(queue.IsEmpty(), t)
+	AssertEqual(queue.Count(), 0, t)
+	_, validPeek := queue.Peek()
+	AssertFalse(validPeek, t)
+	_, rest, validDequeue := queue.Dequeue()
+	AssertFalse(validDequeue, t)
+	AssertTrue(rest.IsEmpty(), t)
+}
+
+func TestPersistentQueueOrder(t *testing.T) {
+	queue := PersistentQueue[int]{}
+	for i := 0; i < 100; i++ {
+		queue = queue.Enqueue(i)
+		if i%3 == 0 {
+			// Interleave dequeues so the front and rear both get exercised.
+			var value int
+			value, queue, _ = queue.Dequeue()
+			AssertEqual(value, i/3, t)
+		}
+	}
+	AssertEqual(queue.Count(), 66, t)
+	peek, _ := queue.Peek()
+	AssertEqual(peek, 34, t)
+	expected := []int{}
+	for i := 34; i < 100; i++ {
+		expected = append(expected, i)
+	}
+	AssertEqualSlice(queue.Slice(), expected, t)
+}
+
+func TestPersistentQueueVersionsAreIndependent(t *testing.T) {
+	base := PersistentQueue[string]{}.Enqueue("a").Enqueue("b").Enqueue("c")
+	left := base.Enqueue("left")
+	right := base.Enqueue("right")
+	_, shorter, _ := base.Dequeue()
+
+	AssertEqualSlice(base.Slice(), []string{"a", "b", "c"}, t)
+	AssertEqualSlice(left.Slice(), []string{"a", "b", "c", "left"}, t)
+	AssertEqualSlice(right.Slice(), []string{"a", "b", "c", "right"}, t)
+	AssertEqualSlice(shorter.Slice(), []string{"b", "c"}, t)
+
+	// Dequeuing from one version doesn't disturb another.
+	_, leftRest, _ := left.Dequeue()
+	AssertEqualSlice(leftRest.Slice(), []string{"b", "c", "left"}, t)
+	AssertEqualSlice(left.Slice(), []string{"a", "b", "c", "left"}, t)
+}
+
+func TestPersistentQueueBacktracking(t *testing.T) {
+	// Explore every path of a small tree, keeping the work list of each branch.
+	var explore func(queue PersistentQueue[int], depth int) int
+	explore = func(queue PersistentQueue[int], depth int) int {
+		if depth == 0 {
+			return queue.Count()
+		}
+		value, rest, _ := queue.Dequeue()
+		return explore(rest.Enqueue(value*2), depth-1) + explore(rest.Enqueue(value*2+1).Enqueue(0), depth-1)
+	}
+	start := PersistentQueue[int]{}.Enqueue(1).Enqueue(2)
+	// Each level the left branch keeps the count and the right branch adds one.
+	AssertEqual(explore(start, 4), 16*2+32, t)
+	AssertEqualSlice(start.Slice(), []int{1, 2}, t)
+}
+
+func TestPersistentQueueSharedAcrossGoroutines(t *testing.T) {
+	queue := PersistentQueue[int]{}
+	for i := 0; i < 1000; i++ {
+		queue = queue.Enqueue(i)
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			version := queue
+			for i := 0; i < 1000; i++ {
+				var value int
+				value, version, _ = version.Dequeue()
+				if value != i {
+					t.Errorf("expected %d got %d", i, value)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
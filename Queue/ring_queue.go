@@ -0,0 +1,140 @@
+package queue
+
+import "sync"
+
+/*
+Determines what a RingQueue does when an element is enqueued while it is full.
+*/
+type OverflowPolicy int
+
+const (
+	Reject    OverflowPolicy = iota // Enqueue fails and the new element is dropped.
+	Block                           // Enqueue waits until a Dequeue frees a slot.
+	Overwrite                       // The oldest element is dropped to make room.
+)
+
+/*
+First-in first-out queue (FIFO) backed by a fixed-size ring buffer.
+
+The storage is allocated once when the queue is created and is never
+reallocated, which makes the queue suitable for bounded telemetry buffers.
+Enqueue and Dequeue are O(1) operations. The queue is safe for concurrent use.
+*/
+type RingQueue[T any] struct {
+	mutex   sync.Mutex
+	notFull *sync.Cond
+	slice   []T            // The ring buffer, whose length is the capacity.
+	head    int            // The index of the oldest element.
+	count   int            // The number of elements in the buffer.
+	dropped uint64         // The number of elements rejected or overwritten.
+	policy  OverflowPolicy // What to do with an Enqueue while the buffer is full.
+}
+
+/*
+Creates an empty queue that holds at most capacity elements.
+Crashes if capacity is not positive or policy is not one of the OverflowPolicy constants.
+*/
+func RingQueueInit[T any](capacity int, policy OverflowPolicy) *RingQueue[T] {
+	if capacity <= 0 {
+		panic("capacity must be greater than 0")
+	}
+	if policy < Reject || policy > Overwrite {
+		panic("unknown overflow policy")
+	}
+	queue := &RingQueue[T]{slice: make([]T, capacity), policy: policy}
+	queue.notFull = sync.NewCond(&queue.mutex)
+	return queue
+}
+
+func (self *RingQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+func (self *RingQueue[T]) IsFull() bool {
+	return self.Count() == self.Capacity()
+}
+
+func (self *RingQueue[T]) Count() int {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.count
+}
+
+func (self *RingQueue[T]) Capacity() int {
+	return len(self.slice)
+}
+
+/*
+Returns the number of elements that were rejected (Reject) or overwritten
+(Overwrite) because the queue was full.
+*/
+func (self *RingQueue[T]) Dropped() uint64 {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.dropped
+}
+
+/*
+Adds an element to the back of the queue. Returns false if the queue is
+full and the policy is Reject. With Block it waits for room, and with
+Overwrite it drops the oldest element; both always return true.
+*/
+func (self *RingQueue[T]) Enqueue(element T) bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.count == len(self.slice) {
+		switch self.policy {
+		case Reject:
+			self.dropped += 1
+			return false
+		case Block:
+			for self.count == len(self.slice) {
+				self.notFull.Wait()
+			}
+		case Overwrite:
+			self.dropped += 1
+			self.pop()
+		}
+	}
+
+	self.slice[(self.head+self.count)%len(self.slice)] = element
+	self.count += 1
+	return true
+}
+
+func (self *RingQueue[T]) Dequeue() (T, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.count == 0 {
+		var element T
+		return element, false
+	}
+	element := self.pop()
+	self.notFull.Signal()
+	return element, true
+}
+
+func (self *RingQueue[T]) Peek() (T, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.count == 0 {
+		var element T
+		return element, false
+	}
+	return self.slice[self.head], true
+}
+
+/*
+Removes the oldest element. The queue must not be empty and the mutex must be held.
+*/
+func (self *RingQueue[T]) pop() T {
+	element := self.slice[self.head]
+	var zero T
+	self.slice[self.head] = zero
+	self.head = (self.head + 1) % len(self.slice)
+	self.count -= 1
+	return element
+}
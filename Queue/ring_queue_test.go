@@ -0,0 +1,132 @@
+package queue
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestRingQueueEmpty(t *testing.T) {
+	queue := RingQueueInit[int](3, Reject)
+	AssertTrue(queue.IsEmpty(), t)
+	AssertFalse(queue.IsFull(), t)
+	AssertEqual(queue.Count(), 0, t)
+	AssertEqual(queue.Capacity(), 3, t)
+	_, validPeek := queue.Peek()
+	AssertFalse(validPeek, t)
+	_, validDequeue := queue.Dequeue()
+	AssertFalse(validDequeue, t)
+}
+
+func TestRingQueueWrapsAround(t *testing.T) {
+	queue := RingQueueInit[int](3, Reject)
+	for round := 0; round < 5; round++ {
+		AssertTrue(queue.Enqueue(round*10+1), t)
+		AssertTrue(queue.Enqueue(round*10+2), t)
+		value, _ := queue.Dequeue()
+		AssertEqual(value, round*10+1, t)
+		value, _ = queue.Dequeue()
+		AssertEqual(value, round*10+2, t)
+	}
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+func TestRingQueueReject(t *testing.T) {
+	queue := RingQueueInit[string](2, Reject)
+	AssertTrue(queue.Enqueue("a"), t)
+	AssertTrue(queue.Enqueue("b"), t)
+	AssertTrue(queue.IsFull(), t)
+	AssertFalse(queue.Enqueue("c"), t)
+	AssertEqual(queue.Dropped(), uint64(1), t)
+
+	value, _ := queue.Dequeue()
+	AssertEqual(value, "a", t)
+	AssertTrue(queue.Enqueue("c"), t)
+	value, _ = queue.Dequeue()
+	AssertEqual(value, "b", t)
+	value, _ = queue.Peek()
+	AssertEqual(value, "c", t)
+}
+
+func TestRingQueueOverwrite(t *testing.T) {
+	queue := RingQueueInit[int](3, Overwrite)
+	for i := 1; i <= 7; i++ {
+		AssertTrue(queue.Enqueue(i), t)
+	}
+	AssertEqual(queue.Count(), 3, t)
+	AssertEqual(queue.Dropped(), uint64(4), t)
+	for _, expected := range []int{5, 6, 7} {
+		value, valid := queue.Dequeue()
+		AssertTrue(valid, t)
+		AssertEqual(value, expected, t)
+	}
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+func TestRingQueueBlock(t *testing.T) {
+	queue := RingQueueInit[int](1, Block)
+	queue.Enqueue(1)
+
+	done := make(chan struct{})
+	go func() {
+		queue.Enqueue(2)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Enqueue on a full blocking queue returned early")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	value, _ := queue.Dequeue()
+	AssertEqual(value, 1, t)
+	<-done
+	value, _ = queue.Dequeue()
+	AssertEqual(value, 2, t)
+	AssertEqual(queue.Dropped(), uint64(0), t)
+}
+
+func TestRingQueueBlockConcurrent(t *testing.T) {
+	const producers, perProducer = 4, 1000
+	queue := RingQueueInit[int](8, Block)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Enqueue(1)
+			}
+		}()
+	}
+
+	sum := 0
+	for sum < producers*perProducer {
+		if value, valid := queue.Dequeue(); valid {
+			sum += value
+		} else {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+	AssertTrue(queue.IsEmpty(), t)
+}
+
+func TestRingQueueInvalidCapacity(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	RingQueueInit[int](0, Reject)
+}
+
+func TestRingQueueInvalidPolicy(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	RingQueueInit[int](4, OverflowPolicy(3))
+}
@@ -0,0 +1,260 @@
+package queue
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+)
+
+/*
+Serializes the elements of a SpillQueue to and from its segment files.
+Decode must read back exactly what Encode wrote, and nothing more.
+*/
+type Codec[T any] interface {
+	Encode(writer io.Writer, element T) error
+	Decode(reader io.Reader) (T, error)
+}
+
+/*
+Codec for fixed-size data types (numbers, and arrays or structs made only of
+them) using encoding/binary in little-endian byte order.
+*/
+type BinaryCodec[T any] struct{}
+
+func (BinaryCodec[T]) Encode(writer io.Writer, element T) error {
+	return binary.Write(writer, binary.LittleEndian, element)
+}
+
+func (BinaryCodec[T]) Decode(reader io.Reader) (T, error) {
+	var element T
+	err := binary.Read(reader, binary.LittleEndian, &element)
+	return element, err
+}
+
+type spillSegment struct {
+	path  string
+	count int
+}
+
+/*
+First-in first-out queue (FIFO) for queues that outgrow memory, such as the
+frontier of a breadth-first search over a huge graph.
+
+The queue keeps the elements at its front (head) and back (tail) in memory,
+each holding at most segmentSize elements. Whenever the tail fills up while
+older elements are still waiting, it is written to a temporary segment file
+with the codec, and segments are read back in order once the head runs dry.
+So at most 2 * segmentSize elements are ever held in memory.
+
+Enqueue, Dequeue and Count behave like Queue's. Because there is no room in
+those signatures for I/O errors, the first error is kept and reported by Err.
+If writing a segment fails, its elements stay in memory, so everything
+already queued can still be dequeued, but Enqueue does nothing from then on.
+If reading a segment back fails, its elements are lost, so the queue stops
+there: Dequeue reports an empty queue and Count returns 0. Close removes any
+remaining segment files.
+*/
+type SpillQueue[T any] struct {
+	head        []T
+	headIndex   int                 // The index of the next element to dequeue from head.
+	tail        []T                 // Elements enqueued since the last spill.
+	segments    Queue[spillSegment] // Spilled segments, oldest first.
+	spilled     int                 // The number of elements held in segment files.
+	segmentSize int
+	codec       Codec[T]
+	directory   string
+	err         error
+	lost        bool // Whether elements were lost (or the queue closed), so nothing more can be dequeued.
+}
+
+/*
+Creates an empty queue that spills segments of segmentSize elements to
+temporary files in directory, or in os.TempDir() if directory is empty.
+Crashes if segmentSize is not positive.
+*/
+func SpillQueueInit[T any](segmentSize int, codec Codec[T], directory string) *SpillQueue[T] {
+	if segmentSize <= 0 {
+		panic("segment size must be greater than 0")
+	}
+	return &SpillQueue[T]{
+		head:        make([]T, 0, segmentSize),
+		tail:        make([]T, 0, segmentSize),
+		segmentSize: segmentSize,
+		codec:       codec,
+		directory:   directory,
+	}
+}
+
+func (self *SpillQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+func (self *SpillQueue[T]) Count() int {
+	if self.lost {
+		return 0
+	}
+	return len(self.head) - self.headIndex + self.spilled + len(self.tail)
+}
+
+/*
+Returns the number of elements currently held in segment files.
+*/
+func (self *SpillQueue[T]) Spilled() int {
+	return self.spilled
+}
+
+/*
+Returns the first error the queue ran into, or ErrClosed once it has been closed.
+*/
+func (self *SpillQueue[T]) Err() error {
+	return self.err
+}
+
+func (self *SpillQueue[T]) Enqueue(element T) {
+	if self.err != nil {
+		return
+	}
+	self.tail = append(self.tail, element)
+	if len(self.tail) < self.segmentSize {
+		return
+	}
+
+	if self.segments.IsEmpty() && self.headIndex == len(self.head) {
+		// Nothing is waiting between the head and the tail, so the tail can become the head.
+		self.head, self.tail = self.tail, self.head[:0]
+		self.headIndex = 0
+		return
+	}
+	if err := self.spill(); err != nil {
+		self.err = err
+	}
+}
+
+func (self *SpillQueue[T]) Dequeue() (T, bool) {
+	var element T
+	if !self.fill() {
+		return element, false
+	}
+	element = self.head[self.headIndex]
+	var zero T
+	self.head[self.headIndex] = zero
+	self.headIndex += 1
+	return element, true
+}
+
+func (self *SpillQueue[T]) Peek() (T, bool) {
+	if !self.fill() {
+		var element T
+		return element, false
+	}
+	return self.head[self.headIndex], true
+}
+
+/*
+Empties the queue and removes its segment files. Returns the first error the
+queue ran into, if any. The queue can't be used afterwards.
+*/
+func (self *SpillQueue[T]) Close() error {
+	err := self.err
+	for {
+		segment, valid := self.segments.Dequeue()
+		if !valid {
+			break
+		}
+		if removeErr := os.Remove(segment.path); removeErr != nil && err == nil {
+			err = removeErr
+		}
+	}
+	self.head, self.tail = nil, nil
+	self.headIndex, self.spilled = 0, 0
+	self.err = ErrClosed
+	self.lost = true
+	return err
+}
+
+/*
+Makes sure the head holds the next element to dequeue. Returns false if there is none.
+*/
+func (self *SpillQueue[T]) fill() bool {
+	if self.lost {
+		return false
+	}
+	if self.headIndex < len(self.head) {
+		return true
+	}
+
+	self.head = self.head[:0]
+	self.headIndex = 0
+	if segment, valid := self.segments.Dequeue(); valid {
+		if err := self.load(segment); err != nil {
+			if self.err == nil {
+				self.err = err
+			}
+			self.lost = true
+			return false
+		}
+	} else {
+		self.head, self.tail = self.tail, self.head
+	}
+	return len(self.head) > 0
+}
+
+/*
+Writes the tail to a new segment file and empties it. If that fails, the tail is left as it was.
+*/
+func (self *SpillQueue[T]) spill() error {
+	file, err := os.CreateTemp(self.directory, "spill-queue-*.segment")
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	for _, element := range self.tail {
+		if err = self.codec.Encode(writer, element); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	self.segments.Enqueue(spillSegment{path: file.Name(), count: len(self.tail)})
+	self.spilled += len(self.tail)
+	var zero T
+	for index := range self.tail {
+		self.tail[index] = zero
+	}
+	self.tail = self.tail[:0]
+	return nil
+}
+
+/*
+Reads a segment file into the (empty) head and removes the file.
+*/
+func (self *SpillQueue[T]) load(segment spillSegment) error {
+	defer os.Remove(segment.path)
+	self.spilled -= segment.count
+
+	file, err := os.Open(segment.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for index := 0; index < segment.count; index++ {
+		element, err := self.codec.Decode(reader)
+		if err != nil {
+			return err
+		}
+		self.head = append(self.head, element)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package queue
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func segmentFiles(t *testing.T, directory string) int {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(entries)
+}
+
+func TestSpillQueueEmpty(t *testing.T) {
+	queue := SpillQueueInit[int64](4, BinaryCodec[int64]{}, t.TempDir())
+	AssertTrue(queue.IsEmpty(), t)
+	AssertEqual(queue.Count(), 0, t)
+	_, validPeek := queue.Peek()
+	AssertFalse(validPeek, t)
+	_, validDequeue := queue.Dequeue()
+	AssertFalse(validDequeue, t)
+	AssertTrue(queue.Close() == nil, t)
+}
+
+func TestSpillQueueInMemory(t *testing.T) {
+	directory := t.TempDir()
+	queue := SpillQueueInit[int32](4, BinaryCodec[int32]{}, directory)
+	for i := int32(0); i < 3; i++ {
+		queue.Enqueue(i)
+	}
+	AssertEqual(queue.Spilled(), 0, t)
+	for i := int32(0); i < 3; i++ {
+		value, valid := queue.Dequeue()
+		AssertTrue(valid, t)
+		AssertEqual(value, i, t)
+	}
+	AssertEqual(segmentFiles(t, directory), 0, t)
+}
+
+func TestSpillQueueSpillsAndRestoresOrder(t *testing.T) {
+	directory := t.TempDir()
+	queue := SpillQueueInit[int64](8, BinaryCodec[int64]{}, directory)
+
+	for i := int64(0); i < 100; i++ {
+		queue.Enqueue(i)
+	}
+	AssertEqual(queue.Count(), 100, t)
+	AssertTrue(queue.Spilled() > 0, t)
+	AssertTrue(queue.Count()-queue.Spilled() <= 16, t)
+	AssertEqual(segmentFiles(t, directory), queue.Spilled()/8, t)
+
+	next := int64(0)
+	for i := int64(100); i < 300; i++ {
+		// Interleave so segments are read while new ones are written.
+		queue.Enqueue(i)
+		if i%2 == 0 {
+			value, valid := queue.Dequeue()
+			AssertTrue(valid, t)
+			if value != next {
+				t.Fatalf("expected %d got %d", next, value)
+			}
+			next++
+		}
+	}
+	peek, _ := queue.Peek()
+	AssertEqual(peek, next, t)
+	for ; next < 300; next++ {
+		value, valid := queue.Dequeue()
+		if !valid || value != next {
+			t.Fatalf("expected %d got %d (%v)", next, value, valid)
+		}
+	}
+	AssertTrue(queue.IsEmpty(), t)
+	AssertTrue(queue.Err() == nil, t)
+	AssertEqual(segmentFiles(t, directory), 0, t)
+}
+
+func TestSpillQueueBreadthFirstSearch(t *testing.T) {
+	// Visit an implicit binary tree of 2^12-1 nodes level by level.
+	queue := SpillQueueInit[[2]int32](32, BinaryCodec[[2]int32]{}, t.TempDir())
+	queue.Enqueue([2]int32{1, 0})
+	visited := 0
+	lastDepth := int32(0)
+	for !queue.IsEmpty() {
+		node, _ := queue.Dequeue()
+		AssertTrue(node[1] >= lastDepth, t)
+		lastDepth = node[1]
+		visited++
+		if node[1] < 11 {
+			queue.Enqueue([2]int32{2 * node[0], node[1] + 1})
+			queue.Enqueue([2]int32{2*node[0] + 1, node[1] + 1})
+		}
+	}
+	AssertEqual(visited, 1<<12-1, t)
+	AssertTrue(queue.Err() == nil, t)
+}
+
+func TestSpillQueueCloseRemovesFiles(t *testing.T) {
+	directory := t.TempDir()
+	queue := SpillQueueInit[int64](4, BinaryCodec[int64]{}, directory)
+	for i := int64(0); i < 50; i++ {
+		queue.Enqueue(i)
+	}
+	AssertTrue(segmentFiles(t, directory) > 0, t)
+	AssertTrue(queue.Close() == nil, t)
+	AssertEqual(segmentFiles(t, directory), 0, t)
+	AssertTrue(queue.Err() == ErrClosed, t)
+	AssertEqual(queue.Count(), 0, t)
+
+	queue.Enqueue(1)
+	_, valid := queue.Dequeue()
+	AssertFalse(valid, t)
+}
+
+type failingCodec struct{}
+
+var errEncode = errors.New("encode failed")
+
+func (failingCodec) Encode(writer io.Writer, element int) error {
+	return errEncode
+}
+
+func (failingCodec) Decode(reader io.Reader) (int, error) {
+	return 0, io.EOF
+}
+
+func TestSpillQueueCodecError(t *testing.T) {
+	directory := t.TempDir()
+	queue := SpillQueueInit[int](2, failingCodec{}, directory)
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+	AssertTrue(queue.Err() == errEncode, t)
+	// The tail that couldn't be spilled stays in memory; only the last Enqueue was dropped.
+	AssertEqual(queue.Count(), 4, t)
+	AssertEqual(segmentFiles(t, directory), 0, t)
+
+	var dequeued []int
+	for !queue.IsEmpty() {
+		value, valid := queue.Dequeue()
+		AssertTrue(valid, t)
+		dequeued = append(dequeued, value)
+	}
+	AssertEqualSlice(dequeued, []int{0, 1, 2, 3}, t)
+	_, valid := queue.Dequeue()
+	AssertFalse(valid, t)
+	AssertTrue(queue.Close() == errEncode, t)
+}
+
+// failingDecodeCodec writes segments fine but can't read them back.
+type failingDecodeCodec struct {
+	BinaryCodec[int64]
+}
+
+var errDecode = errors.New("decode failed")
+
+func (failingDecodeCodec) Decode(reader io.Reader) (int64, error) {
+	return 0, errDecode
+}
+
+func TestSpillQueueDecodeError(t *testing.T) {
+	directory := t.TempDir()
+	queue := SpillQueueInit[int64](2, failingDecodeCodec{}, directory)
+	for i := int64(0); i < 10; i++ {
+		queue.Enqueue(i)
+	}
+	AssertTrue(queue.Spilled() > 0, t)
+
+	// The in-memory head is served until the lost segment is reached.
+	var dequeued []int64
+	for !queue.IsEmpty() {
+		value, valid := queue.Dequeue()
+		if !valid {
+			break
+		}
+		dequeued = append(dequeued, value)
+	}
+	AssertEqualSlice(dequeued, []int64{0, 1}, t)
+	AssertTrue(queue.Err() == errDecode, t)
+	AssertTrue(queue.IsEmpty(), t)
+	AssertEqual(queue.Count(), 0, t)
+	AssertTrue(queue.Close() == errDecode, t)
+	AssertEqual(segmentFiles(t, directory), 0, t)
+}
@@ -0,0 +1,96 @@
+package queue
+
+import "sync/atomic"
+
+// cacheLinePad separates fields written by different goroutines so they don't share a cache line.
+type cacheLinePad [64]byte
+
+/*
+Lock-free bounded queue for exactly one producer goroutine and one consumer
+goroutine (single-producer/single-consumer).
+
+The producer only ever writes tail and the consumer only ever writes head, so
+each side needs nothing more than an atomic load of the other's index and an
+atomic store of its own. Enqueue and Dequeue are O(1) and never block.
+*/
+type SPSCQueue[T any] struct {
+	head  uint64 // The position of the next element to dequeue. Written by the consumer.
+	_     cacheLinePad
+	tail  uint64 // The position of the next free slot. Written by the producer.
+	_     cacheLinePad
+	mask  uint64
+	slice []T
+}
+
+/*
+Creates an empty queue. The capacity is rounded up to a power of two.
+Crashes if capacity is not positive.
+*/
+func SPSCQueueInit[T any](capacity int) *SPSCQueue[T] {
+	size := powerOfTwo(capacity)
+	return &SPSCQueue[T]{mask: size - 1, slice: make([]T, size)}
+}
+
+func (self *SPSCQueue[T]) Capacity() int {
+	return len(self.slice)
+}
+
+/*
+Returns the number of elements in the queue. It is only a snapshot when
+called while the other side is running.
+*/
+func (self *SPSCQueue[T]) Count() int {
+	head := atomic.LoadUint64(&self.head)
+	return int(atomic.LoadUint64(&self.tail) - head)
+}
+
+func (self *SPSCQueue[T]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+/*
+Adds an element to the back of the queue. Returns false if the queue is full.
+Must only be called from the producer goroutine.
+*/
+func (self *SPSCQueue[T]) Enqueue(element T) bool {
+	tail := atomic.LoadUint64(&self.tail)
+	if tail-atomic.LoadUint64(&self.head) == uint64(len(self.slice)) {
+		return false
+	}
+	self.slice[tail&self.mask] = element
+	// Publishing the new tail makes the element visible to the consumer.
+	atomic.StoreUint64(&self.tail, tail+1)
+	return true
+}
+
+/*
+Removes the element at the front of the queue. Returns false if the queue is empty.
+Must only be called from the consumer goroutine.
+*/
+func (self *SPSCQueue[T]) Dequeue() (T, bool) {
+	head := atomic.LoadUint64(&self.head)
+	if head == atomic.LoadUint64(&self.tail) {
+		var element T
+		return element, false
+	}
+	var zero T
+	element := self.slice[head&self.mask]
+	self.slice[head&self.mask] = zero
+	// Publishing the new head hands the slot back to the producer.
+	atomic.StoreUint64(&self.head, head+1)
+	return element, true
+}
+
+/*
+Rounds capacity up to the next power of two. Crashes if capacity is not positive.
+*/
+func powerOfTwo(capacity int) uint64 {
+	if capacity <= 0 {
+		panic("capacity must be greater than 0")
+	}
+	size := uint64(1)
+	for size < uint64(capacity) {
+		size <<= 1
+	}
+	return size
+}
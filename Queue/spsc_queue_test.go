@@ -0,0 +1,58 @@
+package queue
+
+import (
+	"runtime"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestSPSCQueueSequential(t *testing.T) {
+	queue := SPSCQueueInit[int](3)
+	AssertEqual(queue.Capacity(), 4, t)
+	AssertTrue(queue.IsEmpty(), t)
+	_, valid := queue.Dequeue()
+	AssertFalse(valid, t)
+
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 4; i++ {
+			AssertTrue(queue.Enqueue(i), t)
+		}
+		AssertFalse(queue.Enqueue(4), t)
+		AssertEqual(queue.Count(), 4, t)
+		for i := 0; i < 4; i++ {
+			value, valid := queue.Dequeue()
+			AssertTrue(valid, t)
+			AssertEqual(value, i, t)
+		}
+		AssertTrue(queue.IsEmpty(), t)
+	}
+}
+
+func TestSPSCQueueStress(t *testing.T) {
+	const items = 200000
+	queue := SPSCQueueInit[int](64)
+
+	go func() {
+		for i := 0; i < items; {
+			if queue.Enqueue(i) {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	for expected := 0; expected < items; {
+		value, valid := queue.Dequeue()
+		if !valid {
+			runtime.Gosched()
+			continue
+		}
+		if value != expected {
+			t.Fatalf("expected %d got %d", expected, value)
+		}
+		expected++
+	}
+	AssertTrue(queue.IsEmpty(), t)
+}
@@ -0,0 +1,368 @@
+package shuntingyard
+
+import (
+	"math"
+	"strings"
+
+	. "github.com/Jcowwell/go-algorithm-club/Stack"
+)
+
+type associativity int
+
+const (
+	leftAssociative associativity = iota
+	rightAssociative
+)
+
+/*
+Returns how tightly an operator binds and which way it groups. Unary minus
+binds tighter than multiplication but looser than exponentiation, so -2^2 is -4.
+*/
+func operatorInfo(token Token) (int, associativity) {
+	if token.Kind == Negate {
+		return 3, rightAssociative
+	}
+	switch token.Text {
+	case "+", "-":
+		return 1, leftAssociative
+	case "*", "/", "%":
+		return 2, leftAssociative
+	default: // ^
+		return 4, rightAssociative
+	}
+}
+
+/*
+A built-in function. An Arity of -1 means the function takes one or more arguments.
+*/
+type BuiltinFunction struct {
+	Arity int
+	Apply func(arguments []float64) float64
+}
+
+func unary(apply func(float64) float64) BuiltinFunction {
+	return BuiltinFunction{Arity: 1, Apply: func(arguments []float64) float64 { return apply(arguments[0]) }}
+}
+
+/*
+The functions expressions can call. Add to it to make more functions available.
+*/
+var Functions = map[string]BuiltinFunction{
+	"abs":   unary(math.Abs),
+	"sqrt":  unary(math.Sqrt),
+	"exp":   unary(math.Exp),
+	"ln":    unary(math.Log),
+	"log":   unary(math.Log10),
+	"sin":   unary(math.Sin),
+	"cos":   unary(math.Cos),
+	"tan":   unary(math.Tan),
+	"floor": unary(math.Floor),
+	"ceil":  unary(math.Ceil),
+	"pow":   {Arity: 2, Apply: func(arguments []float64) float64 { return math.Pow(arguments[0], arguments[1]) }},
+	"min": {Arity: -1, Apply: func(arguments []float64) float64 {
+		result := arguments[0]
+		for _, argument := range arguments[1:] {
+			result = math.Min(result, argument)
+		}
+		return result
+	}},
+	"max": {Arity: -1, Apply: func(arguments []float64) float64 {
+		result := arguments[0]
+		for _, argument := range arguments[1:] {
+			result = math.Max(result, argument)
+		}
+		return result
+	}},
+}
+
+/*
+An open parenthesis while converting to reverse Polish notation: whether it
+starts a function call, and how many commas have been seen inside it.
+*/
+type parenthesis struct {
+	call   bool
+	commas int
+}
+
+/*
+Converts an infix expression, as returned by Tokenize, to reverse Polish
+notation with the shunting-yard algorithm.
+
+Operands go straight to the output, while operators wait on a stack until an
+operator that binds less tightly, a closing parenthesis or the end of the
+expression pushes them out. The tokens are checked along the way, so missing
+operands, misplaced commas and unbalanced parentheses are reported with the
+position of the offending token. Function tokens in the result have their
+Arity set.
+*/
+func ReversePolishNotation(expression []Token) ([]Token, error) {
+	var output []Token
+	var operators Stack[Token]
+	var parentheses Stack[parenthesis]
+	expectOperand := true
+	end := 0
+	if len(expression) > 0 {
+		last := expression[len(expression)-1]
+		end = last.Position + len(last.Text)
+	}
+
+	for index, token := range expression {
+		switch token.Kind {
+		case Number, Variable:
+			if !expectOperand {
+				return nil, errorAt(token.Position, "unexpected %q, expected an operator", token.Text)
+			}
+			output = append(output, token)
+			expectOperand = false
+
+		case Function:
+			if !expectOperand {
+				return nil, errorAt(token.Position, "unexpected %q, expected an operator", token.Text)
+			}
+			operators.Push(token)
+			// Tokenize only makes a Function when a parenthesis follows, which stays open for the call.
+
+		case Negate:
+			if !expectOperand {
+				return nil, errorAt(token.Position, "unexpected %q", token.Text)
+			}
+			// A prefix operator has no left operand yet, so it can't push anything out.
+			operators.Push(token)
+
+		case Operator:
+			if expectOperand {
+				return nil, errorAt(token.Position, "missing operand before %q", token.Text)
+			}
+			precedence, associativity := operatorInfo(token)
+			for {
+				top, valid := operators.Peek()
+				if !valid || (top.Kind != Operator && top.Kind != Negate) {
+					break
+				}
+				topPrecedence, _ := operatorInfo(top)
+				if topPrecedence < precedence || (topPrecedence == precedence && associativity == rightAssociative) {
+					break
+				}
+				operators.Pop()
+				output = append(output, top)
+			}
+			operators.Push(token)
+			expectOperand = true
+
+		case LeftParenthesis:
+			call := index > 0 && expression[index-1].Kind == Function
+			if !expectOperand && !call {
+				return nil, errorAt(token.Position, "unexpected %q, expected an operator", token.Text)
+			}
+			operators.Push(token)
+			parentheses.Push(parenthesis{call: call})
+			expectOperand = true
+
+		case Comma:
+			frame, valid := parentheses.Peek()
+			if !valid || !frame.call {
+				return nil, errorAt(token.Position, "unexpected %q outside of a function call", token.Text)
+			}
+			if expectOperand {
+				return nil, errorAt(token.Position, "missing argument before %q", token.Text)
+			}
+			output = popUntilParenthesis(&operators, output)
+			parentheses.Pop()
+			frame.commas += 1
+			parentheses.Push(frame)
+			expectOperand = true
+
+		case RightParenthesis:
+			frame, valid := parentheses.Pop()
+			if !valid {
+				return nil, errorAt(token.Position, "unmatched %q", token.Text)
+			}
+			empty := index > 0 && expression[index-1].Kind == LeftParenthesis
+			if expectOperand && !(empty && frame.call) {
+				return nil, errorAt(token.Position, "missing operand before %q", token.Text)
+			}
+			output = popUntilParenthesis(&operators, output)
+			operators.Pop() // The opening parenthesis.
+			if frame.call {
+				function, _ := operators.Pop()
+				function.Arity = frame.commas + 1
+				if empty {
+					function.Arity = 0
+				}
+				output = append(output, function)
+			}
+			expectOperand = false
+		}
+	}
+
+	if expectOperand {
+		return nil, errorAt(end, "unexpected end of expression")
+	}
+	for {
+		top, valid := operators.Pop()
+		if !valid {
+			break
+		}
+		if top.Kind == LeftParenthesis {
+			return nil, errorAt(top.Position, "unmatched %q", top.Text)
+		}
+		output = append(output, top)
+	}
+	return output, nil
+}
+
+/*
+Moves operators to the output until the innermost opening parenthesis, which stays on the stack.
+*/
+func popUntilParenthesis(operators *Stack[Token], output []Token) []Token {
+	for {
+		top, _ := operators.Peek()
+		if top.Kind == LeftParenthesis {
+			return output
+		}
+		operators.Pop()
+		output = append(output, top)
+	}
+}
+
+/*
+Tokenizes an infix expression and converts it to reverse Polish notation.
+*/
+func Parse(expression string) ([]Token, error) {
+	tokens, err := Tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	return ReversePolishNotation(tokens)
+}
+
+/*
+Joins tokens with spaces, for example to print an expression in reverse
+Polish notation. Unary minus is written as "neg" so it can't be mistaken for
+subtraction.
+*/
+func Format(tokens []Token) string {
+	texts := make([]string, len(tokens))
+	for index, token := range tokens {
+		if token.Kind == Negate {
+			texts[index] = "neg"
+		} else {
+			texts[index] = token.Text
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+/*
+Evaluates an expression in reverse Polish notation, looking variables up in
+variables and functions in Functions. Undefined names, calls with the wrong
+number of arguments and division by zero are reported with their position.
+*/
+func Evaluate(expression []Token, variables map[string]float64) (float64, error) {
+	var operands Stack[float64]
+	pop := func(count int, position int) ([]float64, error) {
+		if operands.Count() < count {
+			return nil, errorAt(position, "missing operand")
+		}
+		arguments := make([]float64, count)
+		for index := count - 1; index >= 0; index-- {
+			arguments[index], _ = operands.Pop()
+		}
+		return arguments, nil
+	}
+
+	for _, token := range expression {
+		switch token.Kind {
+		case Number:
+			operands.Push(token.Value)
+
+		case Variable:
+			value, defined := variables[token.Text]
+			if !defined {
+				return 0, errorAt(token.Position, "undefined variable %q", token.Text)
+			}
+			operands.Push(value)
+
+		case Negate:
+			arguments, err := pop(1, token.Position)
+			if err != nil {
+				return 0, err
+			}
+			operands.Push(-arguments[0])
+
+		case Operator:
+			arguments, err := pop(2, token.Position)
+			if err != nil {
+				return 0, err
+			}
+			value, err := apply(token, arguments[0], arguments[1])
+			if err != nil {
+				return 0, err
+			}
+			operands.Push(value)
+
+		case Function:
+			function, defined := Functions[token.Text]
+			if !defined {
+				return 0, errorAt(token.Position, "undefined function %q", token.Text)
+			}
+			if function.Arity >= 0 && token.Arity != function.Arity {
+				return 0, errorAt(token.Position, "%s takes %d arguments, got %d", token.Text, function.Arity, token.Arity)
+			}
+			if function.Arity < 0 && token.Arity == 0 {
+				return 0, errorAt(token.Position, "%s takes at least 1 argument", token.Text)
+			}
+			arguments, err := pop(token.Arity, token.Position)
+			if err != nil {
+				return 0, err
+			}
+			operands.Push(function.Apply(arguments))
+
+		default:
+			return 0, errorAt(token.Position, "unexpected %q in reverse Polish notation", token.Text)
+		}
+	}
+
+	result, valid := operands.Pop()
+	if !valid {
+		return 0, errorAt(0, "empty expression")
+	}
+	if !operands.IsEmpty() {
+		return 0, errorAt(0, "%d operands left over", operands.Count())
+	}
+	return result, nil
+}
+
+func apply(operator Token, left, right float64) (float64, error) {
+	switch operator.Text {
+	case "+":
+		return left + right, nil
+	case "-":
+		return left - right, nil
+	case "*":
+		return left * right, nil
+	case "/":
+		if right == 0 {
+			return 0, errorAt(operator.Position, "division by zero")
+		}
+		return left / right, nil
+	case "%":
+		if right == 0 {
+			return 0, errorAt(operator.Position, "division by zero")
+		}
+		return math.Mod(left, right), nil
+	default: // ^
+		return math.Pow(left, right), nil
+	}
+}
+
+/*
+Parses and evaluates an infix expression.
+*/
+func Eval(expression string, variables map[string]float64) (float64, error) {
+	tokens, err := Parse(expression)
+	if err != nil {
+		return 0, err
+	}
+	return Evaluate(tokens, variables)
+}
@@ -0,0 +1,101 @@
+package shuntingyard
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestReversePolishNotation(t *testing.T) {
+	cases := map[string]string{
+		"4 + 4 * 2 / ( 1 - 5 )": "4 4 2 * 1 5 - / +",
+		"3 - 2 - 1":             "3 2 - 1 -",
+		"2 ^ 3 ^ 2":             "2 3 2 ^ ^",
+		"-2 ^ 2":                "2 2 ^ neg",
+		"-2 * 3":                "2 neg 3 *",
+		"2 * -(1 + x)":          "2 1 x + neg *",
+		"max(1, 2 + 3, y)":      "1 2 3 + y max",
+	}
+	for expression, expected := range cases {
+		rpn, err := Parse(expression)
+		AssertTrue(err == nil, t)
+		AssertEqual(Format(rpn), expected, t)
+	}
+}
+
+func TestFunctionArity(t *testing.T) {
+	rpn, err := Parse("f() + g(1) + h(1, g(2, 3))")
+	AssertTrue(err == nil, t)
+	var arities []int
+	for _, token := range rpn {
+		if token.Kind == Function {
+			arities = append(arities, token.Arity)
+		}
+	}
+	AssertEqualSlice(arities, []int{0, 1, 2, 2}, t)
+}
+
+func TestEval(t *testing.T) {
+	variables := map[string]float64{"x": 3, "y": 4}
+	cases := map[string]float64{
+		"1 + 2 * 3":              7,
+		"(1 + 2) * 3":            9,
+		"2 ^ 3 ^ 2":              512,
+		"-2 ^ 2":                 -4,
+		"2 ^ -1":                 0.5,
+		"--3":                    3,
+		"7 % 4":                  3,
+		"sqrt(x * x + y * y)":    5,
+		"max(x, y, 1) - min(x)":  1,
+		"pow(2, 10) / 1e3":       1.024,
+		"abs(-x) * -abs(y - 10)": -18,
+	}
+	for expression, expected := range cases {
+		value, err := Eval(expression, variables)
+		AssertTrue(err == nil, t)
+		AssertTrue(math.Abs(value-expected) < 1e-9, t)
+	}
+}
+
+func TestSyntaxErrors(t *testing.T) {
+	cases := map[string]int{
+		"":          0,
+		"1 +":       3,
+		"* 2":       0,
+		"1 2":       2,
+		"(1 + 2":    0,
+		"1 + 2)":    5,
+		"()":        1,
+		"2 (3)":     2,
+		"1, 2":      1,
+		"max(1,)":   6,
+		"max(,1)":   4,
+		"sin(1 +)":  7,
+		"x y":       2,
+		"2 * (3 -)": 8,
+	}
+	for expression, position := range cases {
+		_, err := Parse(expression)
+		AssertTrue(err != nil, t)
+		syntaxError, valid := err.(*Error)
+		AssertTrue(valid, t)
+		AssertEqual(syntaxError.Position, position, t)
+	}
+}
+
+func TestEvaluationErrors(t *testing.T) {
+	cases := map[string]int{
+		"1 + z":       4,
+		"nope(1)":     0,
+		"sqrt(1, 2)":  0,
+		"min()":       0,
+		"1 / (x - x)": 2,
+		"4 % 0":       2,
+	}
+	for expression, position := range cases {
+		_, err := Eval(expression, map[string]float64{"x": 1})
+		AssertTrue(err != nil, t)
+		AssertEqual(err.(*Error).Position, position, t)
+	}
+}
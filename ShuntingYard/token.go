@@ -0,0 +1,172 @@
+// Package shuntingyard parses and evaluates infix arithmetic expressions with
+// Dijkstra's shunting-yard algorithm.
+package shuntingyard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type TokenKind int
+
+const (
+	Number           TokenKind = iota // A numeric literal such as 42, 3.14 or 1e-3.
+	Variable                          // A name looked up in the variables when evaluating.
+	Function                          // A name directly followed by an opening parenthesis.
+	Operator                          // A binary operator: + - * / % ^
+	Negate                            // Unary minus.
+	LeftParenthesis                   // (
+	RightParenthesis                  // )
+	Comma                             // Separates the arguments of a function.
+)
+
+/*
+A token of an expression. Position is the byte offset of the token in the
+expression, which errors use to point at the offending input. Arity is only
+set on Function tokens in reverse Polish notation, where it records how many
+arguments the call was given.
+*/
+type Token struct {
+	Kind     TokenKind
+	Text     string
+	Value    float64 // The value of a Number.
+	Position int
+	Arity    int
+}
+
+func (self Token) String() string {
+	return self.Text
+}
+
+/*
+An error in an expression, located at a byte offset.
+*/
+type Error struct {
+	Position int
+	Message  string
+}
+
+func (self *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", self.Position, self.Message)
+}
+
+func errorAt(position int, format string, arguments ...any) *Error {
+	return &Error{Position: position, Message: fmt.Sprintf(format, arguments...)}
+}
+
+/*
+Splits an expression into tokens.
+
+Whitespace separates tokens and is otherwise ignored. A minus sign is unary
+when it starts the expression or follows an operator, an opening parenthesis
+or a comma; a plus sign in that position is dropped. A name is a Function
+when the next token is an opening parenthesis, and a Variable otherwise.
+Tokenize only rejects input it can't split, such as unknown characters or
+malformed numbers. Whether the tokens form a valid expression is checked by
+ReversePolishNotation.
+*/
+func Tokenize(expression string) ([]Token, error) {
+	var tokens []Token
+	expectOperand := true // Whether a + or - here would be unary.
+	for index := 0; index < len(expression); {
+		character, width := utf8.DecodeRuneInString(expression[index:])
+		start := index
+		switch {
+		case unicode.IsSpace(character):
+			index += width
+			continue
+
+		case isDigit(character) || character == '.':
+			index = scanNumber(expression, index)
+			text := expression[start:index]
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, errorAt(start, "malformed number %q", text)
+			}
+			tokens = append(tokens, Token{Kind: Number, Text: text, Value: value, Position: start})
+			expectOperand = false
+
+		case character == '_' || unicode.IsLetter(character):
+			for index < len(expression) {
+				character, width := utf8.DecodeRuneInString(expression[index:])
+				if character != '_' && !unicode.IsLetter(character) && !unicode.IsDigit(character) {
+					break
+				}
+				index += width
+			}
+			kind := Variable
+			if strings.HasPrefix(strings.TrimLeftFunc(expression[index:], unicode.IsSpace), "(") {
+				kind = Function
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: expression[start:index], Position: start})
+			expectOperand = false
+
+		case strings.ContainsRune("+-*/%^", character):
+			index += width
+			if expectOperand && character == '+' {
+				continue
+			}
+			kind := Operator
+			if expectOperand && character == '-' {
+				kind = Negate
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: string(character), Position: start})
+			expectOperand = true
+
+		case character == '(':
+			index += width
+			tokens = append(tokens, Token{Kind: LeftParenthesis, Text: "(", Position: start})
+			expectOperand = true
+
+		case character == ')':
+			index += width
+			tokens = append(tokens, Token{Kind: RightParenthesis, Text: ")", Position: start})
+			expectOperand = false
+
+		case character == ',':
+			index += width
+			tokens = append(tokens, Token{Kind: Comma, Text: ",", Position: start})
+			expectOperand = true
+
+		default:
+			return nil, errorAt(start, "unexpected character %q", character)
+		}
+	}
+	return tokens, nil
+}
+
+func isDigit(character rune) bool {
+	return '0' <= character && character <= '9'
+}
+
+/*
+Returns the end of the number starting at index: digits with an optional
+fraction and an optional exponent.
+*/
+func scanNumber(expression string, index int) int {
+	digits := func() {
+		for index < len(expression) && isDigit(rune(expression[index])) {
+			index++
+		}
+	}
+	digits()
+	if index < len(expression) && expression[index] == '.' {
+		index++
+		digits()
+	}
+	if index < len(expression) && (expression[index] == 'e' || expression[index] == 'E') {
+		exponent := index + 1
+		if exponent < len(expression) && (expression[exponent] == '+' || expression[exponent] == '-') {
+			exponent++
+		}
+		// Only treat the e as an exponent if digits follow, so 2e reads as 2 followed by e.
+		if exponent < len(expression) && isDigit(rune(expression[exponent])) {
+			index = exponent
+			digits()
+		}
+	}
+	return index
+}
@@ -0,0 +1,49 @@
+package shuntingyard
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func kinds(tokens []Token) []TokenKind {
+	result := make([]TokenKind, len(tokens))
+	for index, token := range tokens {
+		result[index] = token.Kind
+	}
+	return result
+}
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize("max(x, 2.5e1) - -3")
+	AssertTrue(err == nil, t)
+	AssertEqualSlice(kinds(tokens), []TokenKind{
+		Function, LeftParenthesis, Variable, Comma, Number, RightParenthesis, Operator, Negate, Number,
+	}, t)
+	AssertEqual(tokens[4].Value, 25.0, t)
+	AssertEqual(tokens[4].Position, 7, t)
+	AssertEqual(tokens[6].Position, 14, t)
+	AssertEqual(tokens[7].Position, 16, t)
+}
+
+func TestTokenizeUnaryPlus(t *testing.T) {
+	tokens, err := Tokenize("+1 * +2")
+	AssertTrue(err == nil, t)
+	AssertEqualSlice(kinds(tokens), []TokenKind{Number, Operator, Number}, t)
+}
+
+func TestTokenizeFunctionWithSpace(t *testing.T) {
+	tokens, err := Tokenize("sqrt (4)")
+	AssertTrue(err == nil, t)
+	AssertEqual(tokens[0].Kind, Function, t)
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	_, err := Tokenize("1 + $")
+	AssertTrue(err != nil, t)
+	AssertEqual(err.(*Error).Position, 4, t)
+
+	_, err = Tokenize("2 * .")
+	AssertTrue(err != nil, t)
+	AssertEqual(err.(*Error).Position, 4, t)
+}
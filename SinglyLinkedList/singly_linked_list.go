@@ -0,0 +1,235 @@
+// Package singlylinkedlist provides a singly linked list and the classic two-pointer
+// algorithms on chains of its nodes.
+package singlylinkedlist
+
+/*
+Singly Linked List's Node Struct
+
+The fields are exported so that chains of nodes can be built and examined
+directly, including shapes a SinglyLinkedList never produces itself, such as
+cycles or two lists that share their tail.
+*/
+type SinglyLinkedListNode[T any] struct {
+	Value T                        // The Node value
+	Next  *SinglyLinkedListNode[T] // The next node, or nil for the last node
+}
+
+/*
+Construction Method to return a new SinglyLinkedListNode
+*/
+func SinglyLinkedListNodeInit[T any](value T) *SinglyLinkedListNode[T] {
+	return &SinglyLinkedListNode[T]{Value: value}
+}
+
+/*
+Singly Linked List's Struct
+
+Every node only points to the next one. The list keeps track of its tail and
+its length, so appending, prepending and Count are O(1).
+*/
+type SinglyLinkedList[T any] struct {
+	head  *SinglyLinkedListNode[T] // The head of the Singly Linked List
+	tail  *SinglyLinkedListNode[T] // The last node of the Singly Linked List
+	count int                      // The # of nodes in the Singly Linked List
+}
+
+/*
+Construction Method to return a new SinglyLinkedList holding the values in order.
+*/
+func SinglyLinkedListInit[T any](values ...T) *SinglyLinkedList[T] {
+	list := &SinglyLinkedList[T]{}
+	for _, value := range values {
+		list.AppendValue(value)
+	}
+	return list
+}
+
+/*
+Method to check if list is empty.
+*/
+func (self *SinglyLinkedList[T]) IsEmpty() bool {
+	return self.head == nil
+}
+
+/*
+Method to return # of elements in SinglyLinkedList. Performance: O(1).
+*/
+func (self *SinglyLinkedList[T]) Count() int {
+	return self.count
+}
+
+/*
+Method to return the first node in SinglyLinkedList.
+*/
+func (self *SinglyLinkedList[T]) Head() *SinglyLinkedListNode[T] {
+	return self.head
+}
+
+/*
+Method to return the last node in SinglyLinkedList. Performance: O(1).
+*/
+func (self *SinglyLinkedList[T]) Tail() *SinglyLinkedListNode[T] {
+	return self.tail
+}
+
+/*
+Method to return the node at a specific index. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *SinglyLinkedList[T]) NodeAt(index int) *SinglyLinkedListNode[T] {
+	if index < 0 || index >= self.count {
+		panic("index is out of bounds")
+	}
+	node := self.head
+	for i := 0; i < index; i++ {
+		node = node.Next
+	}
+	return node
+}
+
+/*
+Method to append a value to the end of the list. Performance: O(1).
+*/
+func (self *SinglyLinkedList[T]) AppendValue(value T) {
+	node := SinglyLinkedListNodeInit(value)
+	if self.IsEmpty() {
+		self.head = node
+	} else {
+		self.tail.Next = node
+	}
+	self.tail = node
+	self.count += 1
+}
+
+/*
+Method to insert a value at the front of the list. Performance: O(1).
+*/
+func (self *SinglyLinkedList[T]) PrependValue(value T) {
+	node := SinglyLinkedListNodeInit(value)
+	node.Next = self.head
+	self.head = node
+	if self.tail == nil {
+		self.tail = node
+	}
+	self.count += 1
+}
+
+/*
+Method to remove a node/value at a specific index. Crashes if index is out of bounds (0...self.Count).
+*/
+func (self *SinglyLinkedList[T]) RemoveAt(index int) T {
+	if index < 0 || index >= self.count {
+		panic("index is out of bounds")
+	}
+	var node *SinglyLinkedListNode[T]
+	if index == 0 {
+		node = self.head
+		self.head = node.Next
+		if self.head == nil {
+			self.tail = nil
+		}
+	} else {
+		prev := self.NodeAt(index - 1)
+		node = prev.Next
+		prev.Next = node.Next
+		if node == self.tail {
+			self.tail = prev
+		}
+	}
+	node.Next = nil
+	self.count -= 1
+	return node.Value
+}
+
+/*
+Method to remove all nodes/value from the list.
+*/
+func (self *SinglyLinkedList[T]) RemoveAll() {
+	self.head = nil
+	self.tail = nil
+	self.count = 0
+}
+
+/*
+Method to return the values of the list in order.
+*/
+func (self *SinglyLinkedList[T]) Slice() []T {
+	values := make([]T, 0, self.count)
+	for node := self.head; node != nil; node = node.Next {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+/*
+Method to return the node k places from the end of the list: k = 0 is the
+last node. Returns nil if the list has k nodes or fewer. See KthFromEnd.
+*/
+func (self *SinglyLinkedList[T]) KthFromEnd(k int) *SinglyLinkedListNode[T] {
+	return KthFromEnd(self.head, k)
+}
+
+/*
+Method to return the middle node of the list, or the first of the two middle
+nodes if the length is even. Returns nil if the list is empty. See Middle.
+*/
+func (self *SinglyLinkedList[T]) Middle() *SinglyLinkedListNode[T] {
+	return Middle(self.head)
+}
+
+/*
+Method to Reverse list in place. Performance: O(n) time, O(1) extra space.
+*/
+func (self *SinglyLinkedList[T]) Reverse() {
+	self.tail = self.head
+	self.head, _ = reverse(self.head, self.count)
+}
+
+/*
+Method to reverse the nodes at indices from up to, but not including, to in
+place, leaving the rest of the list as it is. Crashes unless
+0 <= from <= to <= self.Count. Performance: O(to) time, O(1) extra space.
+*/
+func (self *SinglyLinkedList[T]) ReverseRange(from, to int) {
+	if from < 0 || from > to || to > self.count {
+		panic("range is out of bounds")
+	}
+	if to-from < 2 {
+		return
+	}
+
+	var before *SinglyLinkedListNode[T] // The node in front of the range, if any.
+	first := self.head
+	if from > 0 {
+		before = self.NodeAt(from - 1)
+		first = before.Next
+	}
+
+	// After reversing, the first node of the range is its last.
+	head, after := reverse(first, to-from)
+	first.Next = after
+	if before == nil {
+		self.head = head
+	} else {
+		before.Next = head
+	}
+	if after == nil {
+		self.tail = first
+	}
+}
+
+/*
+Reverses the first count nodes of the chain starting at node. Returns the new
+first node of the reversed part, and the node that followed it (nil if the
+chain had no more nodes). A negative count reverses the whole chain. The last
+node of the reversed part points to nil.
+*/
+func reverse[T any](node *SinglyLinkedListNode[T], count int) (*SinglyLinkedListNode[T], *SinglyLinkedListNode[T]) {
+	var prev *SinglyLinkedListNode[T]
+	for ; count != 0 && node != nil; count-- {
+		next := node.Next
+		node.Next = prev
+		prev = node
+		node = next
+	}
+	return prev, node
+}
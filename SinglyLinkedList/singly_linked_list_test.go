@@ -0,0 +1,91 @@
+package singlylinkedlist
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+/*
+Checks that the tail and the count agree with the chain starting at the head.
+*/
+func assertConsistent[T any](list *SinglyLinkedList[T], t *testing.T) {
+	count := 0
+	var last *SinglyLinkedListNode[T]
+	for node := list.head; node != nil; node = node.Next {
+		last = node
+		count += 1
+	}
+	AssertTrue(list.tail == last, t)
+	AssertEqual(list.count, count, t)
+}
+
+func TestEmptyList(t *testing.T) {
+	list := SinglyLinkedListInit[int]()
+	AssertTrue(list.IsEmpty(), t)
+	AssertEqual(list.Count(), 0, t)
+	AssertTrue(list.Head() == nil, t)
+	AssertTrue(list.Tail() == nil, t)
+	AssertTrue(list.Middle() == nil, t)
+	AssertTrue(list.KthFromEnd(0) == nil, t)
+}
+
+func TestAppendAndPrepend(t *testing.T) {
+	list := SinglyLinkedListInit[int]()
+	list.PrependValue(2)
+	list.AppendValue(3)
+	list.PrependValue(1)
+	AssertEqualSlice(list.Slice(), []int{1, 2, 3}, t)
+	AssertEqual(list.Tail().Value, 3, t)
+	AssertEqual(list.NodeAt(1).Value, 2, t)
+	assertConsistent(list, t)
+}
+
+func TestRemoveAt(t *testing.T) {
+	list := SinglyLinkedListInit(1, 2, 3, 4)
+	AssertEqual(list.RemoveAt(3), 4, t)
+	AssertEqual(list.Tail().Value, 3, t)
+	AssertEqual(list.RemoveAt(0), 1, t)
+	AssertEqual(list.RemoveAt(1), 3, t)
+	AssertEqualSlice(list.Slice(), []int{2}, t)
+	assertConsistent(list, t)
+	AssertEqual(list.RemoveAt(0), 2, t)
+	AssertTrue(list.IsEmpty(), t)
+	assertConsistent(list, t)
+}
+
+func TestReverse(t *testing.T) {
+	list := SinglyLinkedListInit(1, 2, 3, 4)
+	list.Reverse()
+	AssertEqualSlice(list.Slice(), []int{4, 3, 2, 1}, t)
+	assertConsistent(list, t)
+	list.AppendValue(0)
+	AssertEqualSlice(list.Slice(), []int{4, 3, 2, 1, 0}, t)
+}
+
+func TestReverseRange(t *testing.T) {
+	cases := []struct {
+		from, to int
+		expected []int
+	}{
+		{0, 5, []int{5, 4, 3, 2, 1}},
+		{1, 4, []int{1, 4, 3, 2, 5}},
+		{0, 2, []int{2, 1, 3, 4, 5}},
+		{3, 5, []int{1, 2, 3, 5, 4}},
+		{2, 3, []int{1, 2, 3, 4, 5}},
+		{2, 2, []int{1, 2, 3, 4, 5}},
+	}
+	for _, c := range cases {
+		list := SinglyLinkedListInit(1, 2, 3, 4, 5)
+		list.ReverseRange(c.from, c.to)
+		AssertEqualSlice(list.Slice(), c.expected, t)
+		assertConsistent(list, t)
+	}
+}
+
+func TestReverseRangeOutOfBounds(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	SinglyLinkedListInit(1, 2).ReverseRange(1, 3)
+}
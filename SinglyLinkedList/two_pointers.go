@@ -0,0 +1,165 @@
+package singlylinkedlist
+
+/*
+Classic pointer algorithms on chains of nodes. Each one works in O(1) extra
+space by walking the chain with two pointers instead of remembering the nodes
+it has seen. Unless stated otherwise, the chain must not contain a cycle.
+*/
+
+/*
+Floyd's "tortoise and hare" cycle detection. The tortoise moves one node at a
+time and the hare two; if the chain has a cycle the hare catches up with the
+tortoise inside it. Restarting the tortoise from head and moving both one node
+at a time, they then meet at the first node of the cycle.
+Returns that node and true, or nil and false if the chain ends.
+Performance: O(n) time, O(1) extra space.
+*/
+func FloydCycle[T any](head *SinglyLinkedListNode[T]) (*SinglyLinkedListNode[T], bool) {
+	tortoise, hare := head, head
+	for {
+		if hare == nil || hare.Next == nil {
+			return nil, false
+		}
+		tortoise = tortoise.Next
+		hare = hare.Next.Next
+		if tortoise == hare {
+			break
+		}
+	}
+
+	tortoise = head
+	for tortoise != hare {
+		tortoise = tortoise.Next
+		hare = hare.Next
+	}
+	return tortoise, true
+}
+
+/*
+Brent's cycle detection. The hare moves one node at a time and the tortoise
+teleports to it whenever the number of steps reaches the next power of two,
+which finds the cycle length directly and usually takes fewer steps than
+Floyd's. Returns the first node of the cycle, the cycle length and true, or
+nil, 0 and false if the chain ends. Performance: O(n) time, O(1) extra space.
+*/
+func BrentCycle[T any](head *SinglyLinkedListNode[T]) (*SinglyLinkedListNode[T], int, bool) {
+	if head == nil {
+		return nil, 0, false
+	}
+	power, length := 1, 1
+	tortoise, hare := head, head.Next
+	for tortoise != hare {
+		if hare == nil {
+			return nil, 0, false
+		}
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = hare.Next
+		length += 1
+	}
+
+	// Start the hare length nodes ahead; they meet at the start of the cycle.
+	tortoise, hare = head, head
+	for i := 0; i < length; i++ {
+		hare = hare.Next
+	}
+	for tortoise != hare {
+		tortoise = tortoise.Next
+		hare = hare.Next
+	}
+	return tortoise, length, true
+}
+
+/*
+Returns the node k places from the end of the chain: k = 0 is the last node.
+A lead pointer is moved k nodes ahead, then both move until the lead reaches
+the last node. Returns nil if k is negative or the chain has k nodes or fewer.
+Performance: O(n) time, O(1) extra space.
+*/
+func KthFromEnd[T any](head *SinglyLinkedListNode[T], k int) *SinglyLinkedListNode[T] {
+	if head == nil || k < 0 {
+		return nil
+	}
+	lead := head
+	for i := 0; i < k; i++ {
+		lead = lead.Next
+		if lead == nil {
+			return nil
+		}
+	}
+	node := head
+	for lead.Next != nil {
+		lead = lead.Next
+		node = node.Next
+	}
+	return node
+}
+
+/*
+Returns the middle node of the chain, or the first of the two middle nodes if
+its length is even. The fast pointer moves two nodes for every node of the
+slow one, so the slow one is halfway when the fast one reaches the end.
+Returns nil for an empty chain. Performance: O(n) time, O(1) extra space.
+*/
+func Middle[T any](head *SinglyLinkedListNode[T]) *SinglyLinkedListNode[T] {
+	if head == nil {
+		return nil
+	}
+	slow, fast := head, head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	return slow
+}
+
+/*
+Returns whether the values of the chain read the same forwards and backwards.
+The second half is reversed in place so the halves can be compared, then
+reversed back, so the chain is unchanged afterwards.
+Performance: O(n) time, O(1) extra space.
+*/
+func IsPalindrome[T comparable](head *SinglyLinkedListNode[T]) bool {
+	if head == nil {
+		return true
+	}
+	middle := Middle(head)
+	second, _ := reverse(middle.Next, -1)
+
+	palindrome := true
+	for left, right := head, second; right != nil; left, right = left.Next, right.Next {
+		if left.Value != right.Value {
+			palindrome = false
+			break
+		}
+	}
+
+	middle.Next, _ = reverse(second, -1)
+	return palindrome
+}
+
+/*
+Returns the first node shared by two chains that merge, or nil if they don't.
+Each pointer walks its own chain and then the other one, so both have walked
+the same distance when they reach the shared part (or the end together).
+Performance: O(n + m) time, O(1) extra space.
+*/
+func Intersection[T any](first, second *SinglyLinkedListNode[T]) *SinglyLinkedListNode[T] {
+	a, b := first, second
+	for a != b {
+		if a == nil {
+			a = second
+		} else {
+			a = a.Next
+		}
+		if b == nil {
+			b = first
+		} else {
+			b = b.Next
+		}
+	}
+	return a
+}
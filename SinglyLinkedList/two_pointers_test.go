@@ -0,0 +1,112 @@
+package singlylinkedlist
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+/*
+Builds a chain of count nodes whose last node links back to the node at
+index start, or ends if start is negative. Returns the head and the nodes.
+*/
+func buildChain(count, start int) (*SinglyLinkedListNode[int], []*SinglyLinkedListNode[int]) {
+	nodes := make([]*SinglyLinkedListNode[int], count)
+	for i := range nodes {
+		nodes[i] = SinglyLinkedListNodeInit(i)
+		if i > 0 {
+			nodes[i-1].Next = nodes[i]
+		}
+	}
+	if count == 0 {
+		return nil, nodes
+	}
+	if start >= 0 {
+		nodes[count-1].Next = nodes[start]
+	}
+	return nodes[0], nodes
+}
+
+func TestCycleDetectionWithoutCycle(t *testing.T) {
+	for _, count := range []int{0, 1, 2, 7} {
+		head, _ := buildChain(count, -1)
+		_, found := FloydCycle(head)
+		AssertFalse(found, t)
+		_, _, found = BrentCycle(head)
+		AssertFalse(found, t)
+	}
+}
+
+func TestCycleDetection(t *testing.T) {
+	for count := 1; count <= 20; count++ {
+		for start := 0; start < count; start++ {
+			head, nodes := buildChain(count, start)
+
+			node, found := FloydCycle(head)
+			AssertTrue(found, t)
+			AssertTrue(node == nodes[start], t)
+
+			node, length, found := BrentCycle(head)
+			AssertTrue(found, t)
+			AssertTrue(node == nodes[start], t)
+			AssertEqual(length, count-start, t)
+		}
+	}
+}
+
+func TestKthFromEnd(t *testing.T) {
+	list := SinglyLinkedListInit(1, 2, 3, 4, 5)
+	AssertEqual(list.KthFromEnd(0).Value, 5, t)
+	AssertEqual(list.KthFromEnd(2).Value, 3, t)
+	AssertEqual(list.KthFromEnd(4).Value, 1, t)
+	AssertTrue(list.KthFromEnd(5) == nil, t)
+	AssertTrue(list.KthFromEnd(-1) == nil, t)
+}
+
+func TestMiddle(t *testing.T) {
+	AssertEqual(SinglyLinkedListInit(1).Middle().Value, 1, t)
+	AssertEqual(SinglyLinkedListInit(1, 2).Middle().Value, 1, t)
+	AssertEqual(SinglyLinkedListInit(1, 2, 3).Middle().Value, 2, t)
+	AssertEqual(SinglyLinkedListInit(1, 2, 3, 4).Middle().Value, 2, t)
+	AssertEqual(SinglyLinkedListInit(1, 2, 3, 4, 5).Middle().Value, 3, t)
+}
+
+func TestIsPalindrome(t *testing.T) {
+	cases := map[string]bool{
+		"":         true,
+		"a":        true,
+		"aa":       true,
+		"ab":       false,
+		"aba":      true,
+		"abba":     true,
+		"abca":     false,
+		"racecar":  true,
+		"racecars": false,
+	}
+	for text, expected := range cases {
+		list := SinglyLinkedListInit([]rune(text)...)
+		AssertEqual(IsPalindrome(list.Head()), expected, t)
+		// The list is restored afterwards.
+		AssertEqual(string(list.Slice()), text, t)
+		assertConsistent(list, t)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	shared := SinglyLinkedListInit(7, 8, 9)
+	first := SinglyLinkedListInit(1, 2, 3)
+	second := SinglyLinkedListInit(4)
+	first.Tail().Next = shared.Head()
+	second.Tail().Next = shared.Head()
+
+	AssertTrue(Intersection(first.Head(), second.Head()) == shared.Head(), t)
+	AssertTrue(Intersection(second.Head(), first.Head()) == shared.Head(), t)
+	AssertTrue(Intersection(shared.Head(), shared.Head()) == shared.Head(), t)
+}
+
+func TestNoIntersection(t *testing.T) {
+	first := SinglyLinkedListInit(1, 2, 3)
+	second := SinglyLinkedListInit(1, 2)
+	AssertTrue(Intersection(first.Head(), second.Head()) == nil, t)
+	AssertTrue(Intersection[int](nil, second.Head()) == nil, t)
+}
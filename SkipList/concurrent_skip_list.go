@@ -0,0 +1,336 @@
+package skiplist
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+type concurrentNode[K constraints.Ordered, V any] struct {
+	key         K
+	value       unsafe.Pointer   // *V
+	next        []unsafe.Pointer // *concurrentNode[K, V] on every level of the node.
+	mutex       sync.Mutex
+	marked      int32 // Set, under mutex, once the node is being deleted.
+	fullyLinked int32 // Set once the node is linked on every level, which makes it part of the set.
+}
+
+func (self *concurrentNode[K, V]) load(level int) *concurrentNode[K, V] {
+	return (*concurrentNode[K, V])(atomic.LoadPointer(&self.next[level]))
+}
+
+func (self *concurrentNode[K, V]) store(level int, node *concurrentNode[K, V]) {
+	atomic.StorePointer(&self.next[level], unsafe.Pointer(node))
+}
+
+func (self *concurrentNode[K, V]) isMarked() bool {
+	return atomic.LoadInt32(&self.marked) == 1
+}
+
+func (self *concurrentNode[K, V]) isFullyLinked() bool {
+	return atomic.LoadInt32(&self.fullyLinked) == 1
+}
+
+/*
+Ordered map that is safe for concurrent use, implemented as a lazy skip list
+(Herlihy, Lev, Luchangco and Shavit).
+
+Lookups never lock: they follow the links like in a SkipList and only check
+two flags on the node they find. Inserting and deleting lock just the nodes
+in front of the affected position, and then check that nothing changed there
+before relinking, retrying otherwise. A key is in the map from the moment its
+node is fully linked until the moment it is marked for deletion, which makes
+Contains, Get, Insert, Put and Delete linearizable.
+
+Iteration is weakly consistent: it never returns a key twice or out of order,
+and returns every key that is present for the whole iteration, but may or
+may not return keys inserted or deleted while it runs.
+*/
+type ConcurrentSkipList[K constraints.Ordered, V any] struct {
+	// The 64-bit fields come first to keep them 8-byte aligned for atomics on 32-bit platforms.
+	count int64
+	seed  uint64                // Advanced atomically to draw node levels.
+	head  *concurrentNode[K, V] // A sentinel without key whose next pointers start every level.
+}
+
+/*
+Creates an empty concurrent skip list. Node levels are drawn from a generator
+seeded with seed, which, unlike a rand.Source, is safe to share between
+goroutines. With a single goroutine the same seed gives the same structure.
+*/
+func ConcurrentSkipListInit[K constraints.Ordered, V any](seed uint64) *ConcurrentSkipList[K, V] {
+	return &ConcurrentSkipList[K, V]{
+		head: &concurrentNode[K, V]{next: make([]unsafe.Pointer, MaxLevel), fullyLinked: 1},
+		seed: seed,
+	}
+}
+
+/*
+Returns the number of keys. It is only a snapshot while other goroutines are writing.
+*/
+func (self *ConcurrentSkipList[K, V]) Count() int {
+	return int(atomic.LoadInt64(&self.count))
+}
+
+func (self *ConcurrentSkipList[K, V]) IsEmpty() bool {
+	return self.Count() == 0
+}
+
+/*
+Returns whether key is in the map. Never blocks. Performance: expected O(log n).
+*/
+func (self *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	_, found := self.Get(key)
+	return found
+}
+
+/*
+Returns the value stored for key. Never blocks. Performance: expected O(log n).
+*/
+func (self *ConcurrentSkipList[K, V]) Get(key K) (V, bool) {
+	node := self.head
+	for level := MaxLevel - 1; level >= 0; level-- {
+		next := node.load(level)
+		for next != nil && next.key < key {
+			node = next
+			next = node.load(level)
+		}
+		if next != nil && next.key == key {
+			if next.isFullyLinked() && !next.isMarked() {
+				return *(*V)(atomic.LoadPointer(&next.value)), true
+			}
+			break
+		}
+	}
+	var value V
+	return value, false
+}
+
+/*
+Stores value for key if the key is not in the map yet. Returns false, and
+leaves the map unchanged, if it is. Performance: expected O(log n).
+*/
+func (self *ConcurrentSkipList[K, V]) Insert(key K, value V) bool {
+	return self.insert(key, value, false)
+}
+
+/*
+Stores value for key, replacing the value already stored for it. Returns true
+if the key is new. Performance: expected O(log n).
+*/
+func (self *ConcurrentSkipList[K, V]) Put(key K, value V) bool {
+	return self.insert(key, value, true)
+}
+
+func (self *ConcurrentSkipList[K, V]) insert(key K, value V, replace bool) bool {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	topLevel := self.randomLevel()
+	for {
+		if found := self.find(key, &preds, &succs); found >= 0 {
+			node := succs[found]
+			if node.isMarked() {
+				// It is being deleted; wait for that to finish and look again.
+				runtime.Gosched()
+				continue
+			}
+			for !node.isFullyLinked() {
+				runtime.Gosched()
+			}
+			if !replace {
+				return false
+			}
+			node.mutex.Lock()
+			if node.isMarked() {
+				node.mutex.Unlock()
+				continue
+			}
+			atomic.StorePointer(&node.value, unsafe.Pointer(&value))
+			node.mutex.Unlock()
+			return false
+		}
+
+		// Lock the predecessors and check that they still point to the successors.
+		locked := 0
+		valid := true
+		for level := 0; valid && level < topLevel; level++ {
+			pred, succ := preds[level], succs[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mutex.Lock()
+			}
+			locked = level + 1
+			valid = !pred.isMarked() && (succ == nil || !succ.isMarked()) && pred.load(level) == succ
+		}
+		if !valid {
+			unlock(&preds, locked)
+			continue
+		}
+
+		node := &concurrentNode[K, V]{key: key, value: unsafe.Pointer(&value), next: make([]unsafe.Pointer, topLevel)}
+		for level := 0; level < topLevel; level++ {
+			node.next[level] = unsafe.Pointer(succs[level])
+		}
+		for level := 0; level < topLevel; level++ {
+			preds[level].store(level, node)
+		}
+		atomic.StoreInt32(&node.fullyLinked, 1)
+		unlock(&preds, locked)
+		atomic.AddInt64(&self.count, 1)
+		return true
+	}
+}
+
+/*
+Removes key and returns the value that was stored for it. Performance: expected O(log n).
+*/
+func (self *ConcurrentSkipList[K, V]) Delete(key K) (V, bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	var victim *concurrentNode[K, V]
+	for {
+		found := self.find(key, &preds, &succs)
+		if victim == nil {
+			// Only a node that is fully linked, and found on its top level, can be deleted.
+			if found < 0 {
+				var value V
+				return value, false
+			}
+			victim = succs[found]
+			if !victim.isFullyLinked() || len(victim.next)-1 != found || victim.isMarked() {
+				var value V
+				return value, false
+			}
+			// Marking the node is the moment it leaves the map.
+			victim.mutex.Lock()
+			if victim.isMarked() {
+				victim.mutex.Unlock()
+				var value V
+				return value, false
+			}
+			atomic.StoreInt32(&victim.marked, 1)
+		}
+
+		// Lock the predecessors and check that they still point to the victim.
+		topLevel := len(victim.next)
+		locked := 0
+		valid := true
+		for level := 0; valid && level < topLevel; level++ {
+			pred := preds[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mutex.Lock()
+			}
+			locked = level + 1
+			valid = !pred.isMarked() && pred.load(level) == victim
+		}
+		if !valid {
+			unlock(&preds, locked)
+			continue
+		}
+
+		for level := topLevel - 1; level >= 0; level-- {
+			preds[level].store(level, victim.load(level))
+		}
+		// The victim keeps its next pointers, so readers standing on it can still move on.
+		victim.mutex.Unlock()
+		unlock(&preds, locked)
+		atomic.AddInt64(&self.count, -1)
+		return *(*V)(atomic.LoadPointer(&victim.value)), true
+	}
+}
+
+/*
+Calls visit for every key from lo up to, but not including, hi in ascending
+order, until visit returns false. The scan is weakly consistent.
+*/
+func (self *ConcurrentSkipList[K, V]) Range(lo, hi K, visit func(key K, value V) bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	self.find(lo, &preds, &succs)
+	self.walk(succs[0], func(key K, value V) bool {
+		return key < hi && visit(key, value)
+	})
+}
+
+/*
+Calls visit for every key in ascending order, until visit returns false. The
+walk is weakly consistent.
+*/
+func (self *ConcurrentSkipList[K, V]) ForEach(visit func(key K, value V) bool) {
+	self.walk(self.head.load(0), visit)
+}
+
+/*
+Returns the keys in ascending order. The snapshot is weakly consistent.
+*/
+func (self *ConcurrentSkipList[K, V]) Keys() []K {
+	var keys []K
+	self.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+/*
+Follows the bottom level from node, visiting the nodes that are in the map.
+*/
+func (self *ConcurrentSkipList[K, V]) walk(node *concurrentNode[K, V], visit func(key K, value V) bool) {
+	for ; node != nil; node = node.load(0) {
+		if !node.isFullyLinked() || node.isMarked() {
+			continue
+		}
+		if !visit(node.key, *(*V)(atomic.LoadPointer(&node.value))) {
+			return
+		}
+	}
+}
+
+/*
+Fills preds and succs with the nodes around key on every level and returns
+the highest level on which a node with key was found, or -1.
+*/
+func (self *ConcurrentSkipList[K, V]) find(key K, preds, succs *[MaxLevel]*concurrentNode[K, V]) int {
+	found := -1
+	pred := self.head
+	for level := MaxLevel - 1; level >= 0; level-- {
+		succ := pred.load(level)
+		for succ != nil && succ.key < key {
+			pred = succ
+			succ = pred.load(level)
+		}
+		if found < 0 && succ != nil && succ.key == key {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = succ
+	}
+	return found
+}
+
+/*
+Unlocks the distinct predecessors on the lowest count levels.
+*/
+func unlock[K constraints.Ordered, V any](preds *[MaxLevel]*concurrentNode[K, V], count int) {
+	for level := 0; level < count; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mutex.Unlock()
+		}
+	}
+}
+
+/*
+Returns a level between 1 and MaxLevel, where each level is half as likely as
+the one below, from a splitmix64 generator that is safe for concurrent use.
+*/
+func (self *ConcurrentSkipList[K, V]) randomLevel() int {
+	bits := atomic.AddUint64(&self.seed, 0x9e3779b97f4a7c15)
+	bits = (bits ^ (bits >> 30)) * 0xbf58476d1ce4e5b9
+	bits = (bits ^ (bits >> 27)) * 0x94d049bb133111eb
+	bits ^= bits >> 31
+
+	level := 1
+	for ; level < MaxLevel && bits&1 == 1; bits >>= 1 {
+		level += 1
+	}
+	return level
+}
@@ -0,0 +1,219 @@
+package skiplist
+
+import (
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestConcurrentSkipListSequential(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	list := ConcurrentSkipListInit[int, int](3)
+	expected := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(500)
+		switch random.Intn(4) {
+		case 0:
+			_, exists := expected[key]
+			AssertEqual(list.Insert(key, i), !exists, t)
+			if !exists {
+				expected[key] = i
+			}
+		case 1:
+			_, exists := expected[key]
+			AssertEqual(list.Put(key, i), !exists, t)
+			expected[key] = i
+		case 2:
+			value, found := list.Delete(key)
+			expectedValue, exists := expected[key]
+			AssertEqual(found, exists, t)
+			AssertEqual(value, expectedValue, t)
+			delete(expected, key)
+		case 3:
+			value, found := list.Get(key)
+			expectedValue, exists := expected[key]
+			AssertEqual(found, exists, t)
+			AssertEqual(value, expectedValue, t)
+		}
+	}
+	AssertEqual(list.Count(), len(expected), t)
+
+	keys := make([]int, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	AssertEqualSlice(list.Keys(), keys, t)
+}
+
+func TestConcurrentSkipListRange(t *testing.T) {
+	list := ConcurrentSkipListInit[int, string](1)
+	for key := 0; key < 50; key += 10 {
+		list.Insert(key, "")
+	}
+	var keys []int
+	list.Range(5, 40, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	AssertEqualSlice(keys, []int{10, 20, 30}, t)
+
+	keys = nil
+	list.ForEach(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return key < 20
+	})
+	AssertEqualSlice(keys, []int{0, 10, 20}, t)
+}
+
+func TestConcurrentInsertAndDelete(t *testing.T) {
+	const workers, keysPerWorker = 8, 2000
+	list := ConcurrentSkipListInit[int, int](11)
+
+	var group sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			for i := 0; i < keysPerWorker; i++ {
+				key := i*workers + worker
+				if !list.Insert(key, key) {
+					t.Errorf("insert of new key %d failed", key)
+				}
+			}
+		}(worker)
+	}
+	group.Wait()
+	AssertEqual(list.Count(), workers*keysPerWorker, t)
+
+	// Delete the odd keys while readers look up the even ones.
+	stop := make(chan struct{})
+	var readers sync.WaitGroup
+	for reader := 0; reader < 4; reader++ {
+		readers.Add(1)
+		go func(reader int) {
+			defer readers.Done()
+			random := rand.New(rand.NewSource(int64(reader)))
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				key := random.Intn(workers*keysPerWorker/2) * 2
+				if value, found := list.Get(key); !found || value != key {
+					t.Errorf("even key %d went missing", key)
+					return
+				}
+			}
+		}(reader)
+	}
+	for worker := 0; worker < workers; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			for key := worker*2 + 1; key < workers*keysPerWorker; key += workers * 2 {
+				if _, found := list.Delete(key); !found {
+					t.Errorf("delete of key %d failed", key)
+				}
+			}
+		}(worker)
+	}
+	group.Wait()
+	close(stop)
+	readers.Wait()
+
+	AssertEqual(list.Count(), workers*keysPerWorker/2, t)
+	keys := list.Keys()
+	AssertEqual(len(keys), workers*keysPerWorker/2, t)
+	for index, key := range keys {
+		AssertEqual(key, index*2, t)
+	}
+}
+
+func TestConcurrentContendedKey(t *testing.T) {
+	const workers, rounds = 8, 500
+	list := ConcurrentSkipListInit[int, int](5)
+	for round := 0; round < rounds; round++ {
+		var inserted, deleted int64
+		var group sync.WaitGroup
+		for worker := 0; worker < workers; worker++ {
+			group.Add(1)
+			go func(worker int) {
+				defer group.Done()
+				if list.Insert(round, worker) {
+					atomic.AddInt64(&inserted, 1)
+				}
+			}(worker)
+		}
+		group.Wait()
+		for worker := 0; worker < workers; worker++ {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				if _, found := list.Delete(round); found {
+					atomic.AddInt64(&deleted, 1)
+				}
+			}()
+		}
+		group.Wait()
+		// Exactly one goroutine wins each race.
+		AssertEqual(inserted, int64(1), t)
+		AssertEqual(deleted, int64(1), t)
+	}
+	AssertTrue(list.IsEmpty(), t)
+}
+
+func TestConcurrentIterationIsWeaklyConsistent(t *testing.T) {
+	list := ConcurrentSkipListInit[int, int](9)
+	// Keys divisible by 3 stay put; the others come and go.
+	for key := 0; key < 900; key += 3 {
+		list.Insert(key, key)
+	}
+
+	stop := make(chan struct{})
+	var group sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			random := rand.New(rand.NewSource(int64(worker)))
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				key := random.Intn(300)*3 + 1 + random.Intn(2)
+				if random.Intn(2) == 0 {
+					list.Put(key, key)
+				} else {
+					list.Delete(key)
+				}
+				runtime.Gosched()
+			}
+		}(worker)
+	}
+
+	for pass := 0; pass < 20; pass++ {
+		stable := 0
+		previous := -1
+		list.ForEach(func(key, value int) bool {
+			AssertTrue(key > previous, t)
+			AssertEqual(value, key, t)
+			previous = key
+			if key%3 == 0 {
+				stable += 1
+			}
+			return true
+		})
+		AssertEqual(stable, 300, t)
+	}
+	close(stop)
+	group.Wait()
+}
@@ -0,0 +1,293 @@
+// Package skiplist provides ordered maps built on skip lists.
+package skiplist
+
+import (
+	"math/rand"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+/*
+The maximum number of levels of a skip list. With every node reaching the
+next level with probability 1/2, that is enough for 2^32 keys.
+*/
+const MaxLevel = 32
+
+type skipListNode[K constraints.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipListNode[K, V] // next[i] is the following node on level i.
+}
+
+/*
+Ordered map implemented as a skip list.
+
+A skip list is a sorted linked list with express lanes: every node is on the
+bottom level, and each node also appears on the next level up with
+probability 1/2. A search starts on the highest level and drops down a level
+whenever the next node would overshoot, so it skips over most of the list
+and takes expected O(log n) steps. Unlike a balanced tree, nothing has to be
+rebalanced: the random levels keep the list balanced in expectation.
+*/
+type SkipList[K constraints.Ordered, V any] struct {
+	head   *skipListNode[K, V] // A sentinel without key whose next pointers start every level.
+	level  int                 // The number of levels in use.
+	count  int
+	random *rand.Rand
+}
+
+/*
+Creates an empty skip list that draws node levels from source. Pass a source
+with a fixed seed to get the same structure on every run, or nil to seed one
+from the current time.
+*/
+func SkipListInit[K constraints.Ordered, V any](source rand.Source) *SkipList[K, V] {
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+	return &SkipList[K, V]{
+		head:   &skipListNode[K, V]{next: make([]*skipListNode[K, V], MaxLevel)},
+		level:  1,
+		random: rand.New(source),
+	}
+}
+
+func (self *SkipList[K, V]) IsEmpty() bool {
+	return self.count == 0
+}
+
+func (self *SkipList[K, V]) Count() int {
+	return self.count
+}
+
+/*
+Returns the value stored for key. Performance: expected O(log n).
+*/
+func (self *SkipList[K, V]) Get(key K) (V, bool) {
+	if node := self.seek(key, nil); node != nil && node.key == key {
+		return node.value, true
+	}
+	var value V
+	return value, false
+}
+
+func (self *SkipList[K, V]) Contains(key K) bool {
+	_, found := self.Get(key)
+	return found
+}
+
+/*
+Stores value for key, replacing the value already stored for it. Returns true
+if the key is new. Performance: expected O(log n).
+*/
+func (self *SkipList[K, V]) Put(key K, value V) bool {
+	var update [MaxLevel]*skipListNode[K, V]
+	if node := self.seek(key, &update); node != nil && node.key == key {
+		node.value = value
+		return false
+	}
+
+	level := self.randomLevel()
+	if level > self.level {
+		for i := self.level; i < level; i++ {
+			update[i] = self.head
+		}
+		self.level = level
+	}
+	node := &skipListNode[K, V]{key: key, value: value, next: make([]*skipListNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	self.count += 1
+	return true
+}
+
+/*
+Removes key and returns the value that was stored for it.
+Performance: expected O(log n).
+*/
+func (self *SkipList[K, V]) Delete(key K) (V, bool) {
+	var update [MaxLevel]*skipListNode[K, V]
+	node := self.seek(key, &update)
+	if node == nil || node.key != key {
+		var value V
+		return value, false
+	}
+
+	for i := range node.next {
+		update[i].next[i] = node.next[i]
+	}
+	// The node keeps its next pointers, so an iterator standing on it can still move on.
+	for self.level > 1 && self.head.next[self.level-1] == nil {
+		self.level -= 1
+	}
+	self.count -= 1
+	return node.value, true
+}
+
+/*
+Removes every key.
+*/
+func (self *SkipList[K, V]) RemoveAll() {
+	for i := range self.head.next {
+		self.head.next[i] = nil
+	}
+	self.level = 1
+	self.count = 0
+}
+
+/*
+Returns the smallest key and its value, or false if the list is empty. Performance: O(1).
+*/
+func (self *SkipList[K, V]) Min() (K, V, bool) {
+	return self.First().entry()
+}
+
+/*
+Returns the largest key and its value, or false if the list is empty.
+Performance: expected O(log n).
+*/
+func (self *SkipList[K, V]) Max() (K, V, bool) {
+	node := self.head
+	for i := self.level - 1; i >= 0; i-- {
+		for node.next[i] != nil {
+			node = node.next[i]
+		}
+	}
+	if node == self.head {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return node.key, node.value, true
+}
+
+/*
+Calls visit for every key from lo up to, but not including, hi in ascending
+order, until visit returns false. Performance: expected O(log n + m) for m keys visited.
+*/
+func (self *SkipList[K, V]) Range(lo, hi K, visit func(key K, value V) bool) {
+	for iterator := self.Seek(lo); iterator.Valid() && iterator.Key() < hi; iterator.Next() {
+		if !visit(iterator.Key(), iterator.Value()) {
+			return
+		}
+	}
+}
+
+/*
+Calls visit for every key in ascending order, until visit returns false.
+*/
+func (self *SkipList[K, V]) ForEach(visit func(key K, value V) bool) {
+	for iterator := self.First(); iterator.Valid(); iterator.Next() {
+		if !visit(iterator.Key(), iterator.Value()) {
+			return
+		}
+	}
+}
+
+/*
+Returns the keys in ascending order.
+*/
+func (self *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, self.count)
+	self.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+/*
+Returns the first node whose key is greater or equal to key, or nil if there
+is none. If update is not nil, it is filled with the last node before that
+position on every level in use, which is where a node would be linked in.
+*/
+func (self *SkipList[K, V]) seek(key K, update *[MaxLevel]*skipListNode[K, V]) *skipListNode[K, V] {
+	node := self.head
+	for i := self.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].key < key {
+			node = node.next[i]
+		}
+		if update != nil {
+			update[i] = node
+		}
+	}
+	return node.next[0]
+}
+
+/*
+Returns a level between 1 and MaxLevel, where each level is half as likely as the one below.
+*/
+func (self *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for bits := self.random.Uint64(); level < MaxLevel && bits&1 == 1; bits >>= 1 {
+		level += 1
+	}
+	return level
+}
+
+/*
+A position in a SkipList used to walk its keys in ascending order.
+
+Putting and deleting keys while iterating is allowed. An iterator on a key
+that gets deleted still moves on to the key that followed it at the time.
+*/
+type Iterator[K constraints.Ordered, V any] struct {
+	node *skipListNode[K, V]
+}
+
+/*
+Returns an iterator on the smallest key.
+*/
+func (self *SkipList[K, V]) First() *Iterator[K, V] {
+	return &Iterator[K, V]{node: self.head.next[0]}
+}
+
+/*
+Returns an iterator on the smallest key greater or equal to key. Performance: expected O(log n).
+*/
+func (self *SkipList[K, V]) Seek(key K) *Iterator[K, V] {
+	return &Iterator[K, V]{node: self.seek(key, nil)}
+}
+
+/*
+Returns false once the iterator has moved past the largest key.
+*/
+func (self *Iterator[K, V]) Valid() bool {
+	return self.node != nil
+}
+
+/*
+Moves to the next key. Returns false if there is none.
+*/
+func (self *Iterator[K, V]) Next() bool {
+	if self.node != nil {
+		self.node = self.node.next[0]
+	}
+	return self.node != nil
+}
+
+/*
+Returns the key the iterator is on. Crashes if the iterator is not Valid.
+*/
+func (self *Iterator[K, V]) Key() K {
+	return self.node.key
+}
+
+/*
+Returns the value the iterator is on. Crashes if the iterator is not Valid.
+*/
+func (self *Iterator[K, V]) Value() V {
+	return self.node.value
+}
+
+func (self *Iterator[K, V]) entry() (K, V, bool) {
+	if self.node == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return self.node.key, self.node.value, true
+}
@@ -0,0 +1,183 @@
+package skiplist
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+/*
+Checks that every level is sorted and only holds nodes of the level below.
+*/
+func assertWellFormed[K int | string, V any](list *SkipList[K, V], t *testing.T) {
+	for i := 1; i < list.level; i++ {
+		below := list.head.next[i-1]
+		for node := list.head.next[i]; node != nil; node = node.next[i] {
+			for below != nil && below != node {
+				below = below.next[i-1]
+			}
+			AssertTrue(below == node, t)
+		}
+	}
+	count := 0
+	for node := list.head.next[0]; node != nil; node = node.next[0] {
+		if node.next[0] != nil {
+			AssertTrue(node.key < node.next[0].key, t)
+		}
+		count += 1
+	}
+	AssertEqual(count, list.Count(), t)
+}
+
+func TestEmptySkipList(t *testing.T) {
+	list := SkipListInit[int, string](rand.NewSource(1))
+	AssertTrue(list.IsEmpty(), t)
+	_, found := list.Get(1)
+	AssertFalse(found, t)
+	_, found = list.Delete(1)
+	AssertFalse(found, t)
+	_, _, found = list.Min()
+	AssertFalse(found, t)
+	_, _, found = list.Max()
+	AssertFalse(found, t)
+	AssertFalse(list.First().Valid(), t)
+}
+
+func TestPutGetDelete(t *testing.T) {
+	list := SkipListInit[string, int](rand.NewSource(1))
+	AssertTrue(list.Put("b", 2), t)
+	AssertTrue(list.Put("a", 1), t)
+	AssertTrue(list.Put("c", 3), t)
+	AssertFalse(list.Put("b", 20), t)
+	AssertEqual(list.Count(), 3, t)
+
+	value, found := list.Get("b")
+	AssertTrue(found, t)
+	AssertEqual(value, 20, t)
+	AssertFalse(list.Contains("d"), t)
+
+	value, found = list.Delete("a")
+	AssertTrue(found, t)
+	AssertEqual(value, 1, t)
+	AssertFalse(list.Contains("a"), t)
+	AssertEqualSlice(list.Keys(), []string{"b", "c"}, t)
+	assertWellFormed(list, t)
+}
+
+func TestAgainstMap(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	list := SkipListInit[int, int](rand.NewSource(7))
+	expected := map[int]int{}
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(1000)
+		switch random.Intn(3) {
+		case 0, 1:
+			_, exists := expected[key]
+			AssertEqual(list.Put(key, i), !exists, t)
+			expected[key] = i
+		case 2:
+			value, found := list.Delete(key)
+			expectedValue, exists := expected[key]
+			AssertEqual(found, exists, t)
+			AssertEqual(value, expectedValue, t)
+			delete(expected, key)
+		}
+	}
+	AssertEqual(list.Count(), len(expected), t)
+	assertWellFormed(list, t)
+
+	keys := make([]int, 0, len(expected))
+	for key, value := range expected {
+		keys = append(keys, key)
+		got, found := list.Get(key)
+		AssertTrue(found, t)
+		AssertEqual(got, value, t)
+	}
+	sort.Ints(keys)
+	AssertEqualSlice(list.Keys(), keys, t)
+
+	min, _, _ := list.Min()
+	max, _, _ := list.Max()
+	AssertEqual(min, keys[0], t)
+	AssertEqual(max, keys[len(keys)-1], t)
+}
+
+func TestSeededStructureIsReproducible(t *testing.T) {
+	build := func() *SkipList[int, int] {
+		list := SkipListInit[int, int](rand.NewSource(42))
+		for i := 0; i < 100; i++ {
+			list.Put(i, i)
+		}
+		return list
+	}
+	first, second := build(), build()
+	AssertEqual(first.level, second.level, t)
+	for a, b := first.head.next[0], second.head.next[0]; a != nil; a, b = a.next[0], b.next[0] {
+		AssertEqual(len(a.next), len(b.next), t)
+	}
+}
+
+func TestSeek(t *testing.T) {
+	list := SkipListInit[int, int](rand.NewSource(1))
+	for _, key := range []int{10, 20, 30, 40} {
+		list.Put(key, key*10)
+	}
+	iterator := list.Seek(20)
+	AssertEqual(iterator.Key(), 20, t)
+	AssertEqual(iterator.Value(), 200, t)
+	AssertTrue(iterator.Next(), t)
+	AssertEqual(iterator.Key(), 30, t)
+
+	AssertEqual(list.Seek(25).Key(), 30, t)
+	AssertEqual(list.Seek(-5).Key(), 10, t)
+	AssertFalse(list.Seek(41).Valid(), t)
+}
+
+func TestRange(t *testing.T) {
+	list := SkipListInit[int, int](rand.NewSource(1))
+	for key := 0; key < 100; key += 5 {
+		list.Put(key, key)
+	}
+	var keys []int
+	list.Range(12, 40, func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	AssertEqualSlice(keys, []int{15, 20, 25, 30, 35}, t)
+
+	keys = nil
+	list.Range(0, 100, func(key, _ int) bool {
+		keys = append(keys, key)
+		return len(keys) < 3
+	})
+	AssertEqualSlice(keys, []int{0, 5, 10}, t)
+}
+
+func TestDeleteWhileIterating(t *testing.T) {
+	list := SkipListInit[int, int](rand.NewSource(1))
+	for key := 0; key < 10; key++ {
+		list.Put(key, key)
+	}
+	var visited []int
+	for iterator := list.First(); iterator.Valid(); iterator.Next() {
+		visited = append(visited, iterator.Key())
+		list.Delete(iterator.Key())
+	}
+	AssertEqualSlice(visited, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, t)
+	AssertTrue(list.IsEmpty(), t)
+	AssertEqual(list.level, 1, t)
+}
+
+func TestRemoveAll(t *testing.T) {
+	list := SkipListInit[int, int](rand.NewSource(1))
+	for key := 0; key < 10; key++ {
+		list.Put(key, key)
+	}
+	list.RemoveAll()
+	AssertTrue(list.IsEmpty(), t)
+	AssertFalse(list.First().Valid(), t)
+	list.Put(1, 1)
+	AssertEqualSlice(list.Keys(), []int{1}, t)
+}
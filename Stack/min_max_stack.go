@@ -0,0 +1,97 @@
+package stack
+
+import "golang.org/x/exp/constraints"
+
+/*
+Last-in first-out stack (LIFO) that also reports its smallest element.
+Push, Pop, Peek and Min are O(1) operations.
+
+Alongside the elements it keeps a second stack of running minima: an element
+is pushed there whenever it is no larger than the current minimum, and popped
+from there when it leaves the main stack.
+*/
+type MinStack[T constraints.Ordered] struct {
+	stack  Stack[T]
+	minima Stack[T]
+}
+
+func (self *MinStack[T]) IsEmpty() bool {
+	return self.stack.IsEmpty()
+}
+
+func (self *MinStack[T]) Count() int {
+	return self.stack.Count()
+}
+
+func (self *MinStack[T]) Push(element T) {
+	self.stack.Push(element)
+	if minimum, valid := self.minima.Peek(); !valid || element <= minimum {
+		self.minima.Push(element)
+	}
+}
+
+func (self *MinStack[T]) Pop() (T, bool) {
+	element, valid := self.stack.Pop()
+	if valid {
+		if minimum, _ := self.minima.Peek(); element == minimum {
+			self.minima.Pop()
+		}
+	}
+	return element, valid
+}
+
+func (self *MinStack[T]) Peek() (T, bool) {
+	return self.stack.Peek()
+}
+
+/*
+Returns the smallest element on the stack.
+*/
+func (self *MinStack[T]) Min() (T, bool) {
+	return self.minima.Peek()
+}
+
+/*
+Last-in first-out stack (LIFO) that also reports its largest element.
+Push, Pop, Peek and Max are O(1) operations.
+*/
+type MaxStack[T constraints.Ordered] struct {
+	stack  Stack[T]
+	maxima Stack[T]
+}
+
+func (self *MaxStack[T]) IsEmpty() bool {
+	return self.stack.IsEmpty()
+}
+
+func (self *MaxStack[T]) Count() int {
+	return self.stack.Count()
+}
+
+func (self *MaxStack[T]) Push(element T) {
+	self.stack.Push(element)
+	if maximum, valid := self.maxima.Peek(); !valid || element >= maximum {
+		self.maxima.Push(element)
+	}
+}
+
+func (self *MaxStack[T]) Pop() (T, bool) {
+	element, valid := self.stack.Pop()
+	if valid {
+		if maximum, _ := self.maxima.Peek(); element == maximum {
+			self.maxima.Pop()
+		}
+	}
+	return element, valid
+}
+
+func (self *MaxStack[T]) Peek() (T, bool) {
+	return self.stack.Peek()
+}
+
+/*
+Returns the largest element on the stack.
+*/
+func (self *MaxStack[T]) Max() (T, bool) {
+	return self.maxima.Peek()
+}
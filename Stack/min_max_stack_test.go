@@ -0,0 +1,59 @@
+package stack
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestMinStackEmpty(t *testing.T) {
+	stack := MinStack[int]{}
+	AssertTrue(stack.IsEmpty(), t)
+	_, validMin := stack.Min()
+	AssertFalse(validMin, t)
+	_, validPop := stack.Pop()
+	AssertFalse(validPop, t)
+}
+
+func TestMinStack(t *testing.T) {
+	stack := MinStack[int]{}
+	pushes := []int{5, 7, 3, 3, 8, 1, 4}
+	minima := []int{5, 5, 3, 3, 3, 1, 1}
+	for index, value := range pushes {
+		stack.Push(value)
+		minimum, _ := stack.Min()
+		AssertEqual(minimum, minima[index], t)
+	}
+	AssertEqual(stack.Count(), len(pushes), t)
+
+	for index := len(pushes) - 1; index > 0; index-- {
+		value, _ := stack.Pop()
+		AssertEqual(value, pushes[index], t)
+		minimum, _ := stack.Min()
+		AssertEqual(minimum, minima[index-1], t)
+	}
+	top, _ := stack.Peek()
+	AssertEqual(top, 5, t)
+}
+
+func TestMaxStack(t *testing.T) {
+	stack := MaxStack[float64]{}
+	pushes := []float64{2, 1, 6, 6, 3, 9, 0}
+	maxima := []float64{2, 2, 6, 6, 6, 9, 9}
+	for index, value := range pushes {
+		stack.Push(value)
+		maximum, _ := stack.Max()
+		AssertEqual(maximum, maxima[index], t)
+	}
+
+	for index := len(pushes) - 1; index > 0; index-- {
+		value, _ := stack.Pop()
+		AssertEqual(value, pushes[index], t)
+		maximum, _ := stack.Max()
+		AssertEqual(maximum, maxima[index-1], t)
+	}
+	stack.Pop()
+	AssertTrue(stack.IsEmpty(), t)
+	_, validMax := stack.Max()
+	AssertFalse(validMax, t)
+}
@@ -0,0 +1,79 @@
+package stack
+
+/*
+A node of a PersistentStack. Nodes are never modified once created, which is
+what allows many stacks to share them.
+*/
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+}
+
+/*
+Immutable last-in first-out stack (LIFO), implemented as a cons list.
+
+Push and Pop return a new stack and leave the receiver untouched; the new
+stack shares every node with the old one, so both are O(1) operations and
+keeping old versions around (for example while backtracking) costs nothing
+extra. The zero value is an empty stack.
+*/
+type PersistentStack[T any] struct {
+	top   *persistentNode[T]
+	count int
+}
+
+func (self PersistentStack[T]) IsEmpty() bool {
+	return self.top == nil
+}
+
+func (self PersistentStack[T]) Count() int {
+	return self.count
+}
+
+/*
+Returns a new stack with element on top. Performance: O(1).
+*/
+func (self PersistentStack[T]) Push(element T) PersistentStack[T] {
+	return PersistentStack[T]{top: &persistentNode[T]{value: element, next: self.top}, count: self.count + 1}
+}
+
+/*
+Returns the top element and the stack below it. Performance: O(1).
+*/
+func (self PersistentStack[T]) Pop() (T, PersistentStack[T], bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, self, false
+	}
+	return self.top.value, PersistentStack[T]{top: self.top.next, count: self.count - 1}, true
+}
+
+func (self PersistentStack[T]) Peek() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	return self.top.value, true
+}
+
+/*
+Returns a new stack with the elements in the opposite order. Performance: O(n).
+*/
+func (self PersistentStack[T]) Reverse() PersistentStack[T] {
+	var reversed PersistentStack[T]
+	for node := self.top; node != nil; node = node.next {
+		reversed = reversed.Push(node.value)
+	}
+	return reversed
+}
+
+/*
+Returns the elements from top to bottom in a new slice.
+*/
+func (self PersistentStack[T]) Slice() []T {
+	result := make([]T, 0, self.count)
+	for node := self.top; node != nil; node = node.next {
+		result = append(result, node.value)
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package stack
+
+import (
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+func TestPersistentStackEmpty(t *testing.T) {
+	stack := PersistentStack[int]{}
+	AssertTrue(stack.IsEmpty(), t)
+	AssertEqual(stack.Count(), 0, t)
+	_, validPeek := stack.Peek()
+	AssertFalse(validPeek, t)
+	_, rest, validPop := stack.Pop()
+	AssertFalse(validPop, t)
+	AssertTrue(rest.IsEmpty(), t)
+}
+
+func TestPersistentStackPushPop(t *testing.T) {
+	empty := PersistentStack[int]{}
+	one := empty.Push(1)
+	two := one.Push(2)
+	three := two.Push(3)
+
+	AssertEqual(three.Count(), 3, t)
+	AssertEqualSlice(three.Slice(), []int{3, 2, 1}, t)
+
+	value, popped, valid := three.Pop()
+	AssertTrue(valid, t)
+	AssertEqual(value, 3, t)
+	AssertEqualSlice(popped.Slice(), []int{2, 1}, t)
+
+	top, _ := popped.Peek()
+	AssertEqual(top, 2, t)
+}
+
+func TestPersistentStackVersionsAreIndependent(t *testing.T) {
+	base := PersistentStack[string]{}.Push("a").Push("b")
+	left := base.Push("left")
+	right := base.Push("right")
+	_, shorter, _ := base.Pop()
+
+	AssertEqualSlice(base.Slice(), []string{"b", "a"}, t)
+	AssertEqualSlice(left.Slice(), []string{"left", "b", "a"}, t)
+	AssertEqualSlice(right.Slice(), []string{"right", "b", "a"}, t)
+	AssertEqualSlice(shorter.Slice(), []string{"a"}, t)
+	// Both branches share the nodes of base.
+	AssertTrue(left.top.next == right.top.next, t)
+}
+
+func TestPersistentStackReverse(t *testing.T) {
+	stack := PersistentStack[int]{}.Push(1).Push(2).Push(3)
+	reversed := stack.Reverse()
+	AssertEqualSlice(reversed.Slice(), []int{1, 2, 3}, t)
+	AssertEqualSlice(stack.Slice(), []int{3, 2, 1}, t)
+}
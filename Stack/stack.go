@@ -1,8 +1,12 @@
 package stack
 
 /*
- Last-in first-out stack (LIFO)
- Push and pop are O(1) operations.
+Last-in first-out stack (LIFO)
+Push and pop are O(1) operations.
+
+The top of the stack is kept at the end of the slice, so pushing and popping
+never move the other elements. Peek and Search still count positions from
+the top: index 0 is the top of the stack.
 */
 type Stack[T comparable] []T
 
@@ -15,7 +19,7 @@ func (self *Stack[T]) Count() int {
 }
 
 func (self *Stack[T]) Push(element T) {
-	*self = append([]T{element}, *self...)
+	*self = append(*self, element)
 }
 
 func (self *Stack[T]) Pop() (T, bool) {
@@ -23,8 +27,11 @@ func (self *Stack[T]) Pop() (T, bool) {
 		var element T
 		return element, false
 	}
-	element := (*self)[0]
-	*self = (*self)[1:]
+	top := len(*self) - 1
+	element := (*self)[top]
+	var zero T
+	(*self)[top] = zero
+	*self = (*self)[:top]
 	return element, true
 }
 
@@ -33,17 +40,33 @@ func (self *Stack[T]) Peek() (T, bool) {
 		var element T
 		return element, false
 	}
-	element := (*self)[0]
+	element := (*self)[len(*self)-1]
 	return element, true
 }
 
 func (self *Stack[T]) Search(element T) (int, bool) {
 	if !self.IsEmpty() {
-		for index, e := range *self {
-			if e == element {
-				return index, true
+		for index := len(*self) - 1; index >= 0; index-- {
+			if (*self)[index] == element {
+				return len(*self) - 1 - index, true
 			}
 		}
 	}
 	return -1, false
 }
+
+/*
+Removes and returns the element at the bottom of the stack, the one pushed
+the longest ago. Useful to cap the size of a stack by dropping its oldest element.
+*/
+func (self *Stack[T]) RemoveBottom() (T, bool) {
+	if self.IsEmpty() {
+		var element T
+		return element, false
+	}
+	element := (*self)[0]
+	var zero T
+	(*self)[0] = zero
+	*self = (*self)[1:]
+	return element, true
+}
@@ -88,3 +88,63 @@ func TestMakeEmpty(t *testing.T) {
 	_, validPop4 := stack.Pop()
 	AssertFalse(validPop4, t)
 }
+
+func TestSearchCountsFromTop(t *testing.T) {
+	stack := Stack[string]{}
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	stack.Push("b")
+
+	index, valid := stack.Search("b")
+	AssertTrue(valid, t)
+	AssertEqual(index, 0, t)
+	index, valid = stack.Search("a")
+	AssertTrue(valid, t)
+	AssertEqual(index, 3, t)
+	index, valid = stack.Search("c")
+	AssertTrue(valid, t)
+	AssertEqual(index, 1, t)
+	_, valid = stack.Search("z")
+	AssertFalse(valid, t)
+}
+
+func TestManyElements(t *testing.T) {
+	stack := Stack[int]{}
+	for i := 0; i < 10000; i++ {
+		stack.Push(i)
+	}
+	AssertEqual(stack.Count(), 10000, t)
+	for i := 9999; i >= 0; i-- {
+		value, _ := stack.Pop()
+		if value != i {
+			t.Fatalf("expected %d got %d", i, value)
+		}
+	}
+	AssertTrue(stack.IsEmpty(), t)
+}
+
+func TestRemoveBottom(t *testing.T) {
+	stack := Stack[int]{}
+	_, valid := stack.RemoveBottom()
+	AssertFalse(valid, t)
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	bottom, valid := stack.RemoveBottom()
+	AssertTrue(valid, t)
+	AssertEqual(bottom, 1, t)
+	AssertEqual(stack.Count(), 2, t)
+	top, _ := stack.Peek()
+	AssertEqual(top, 3, t)
+
+	stack.Push(4)
+	bottom, _ = stack.RemoveBottom()
+	AssertEqual(bottom, 2, t)
+	value, _ := stack.Pop()
+	AssertEqual(value, 4, t)
+	value, _ = stack.Pop()
+	AssertEqual(value, 3, t)
+	AssertTrue(stack.IsEmpty(), t)
+}
@@ -0,0 +1,297 @@
+// Package unrolledlinkedlist provides a linked list that stores several elements per node.
+package unrolledlinkedlist
+
+/*
+The default number of elements a node of an UnrolledLinkedList holds.
+*/
+const DefaultNodeCapacity = 64
+
+type unrolledNode[T any] struct {
+	elements []T // Between 1 and the list's node capacity elements, in order.
+	next     *unrolledNode[T]
+	prev     *unrolledNode[T]
+}
+
+/*
+Unrolled linked list: a doubly linked list whose nodes each hold a small
+array of elements instead of a single one.
+
+Packing elements together means one allocation per node rather than per
+element, less memory spent on pointers, and elements that sit next to each
+other in memory while iterating. Finding an index still walks the list, but
+it skips a whole node at a time.
+
+Every node but the last is kept between half full and full. Appending fills
+the last node and then starts a new one, inserting into a full node splits it
+into two half-full nodes, and when removing leaves a node less than half
+full, it takes elements from its successor, or merges with it if they fit
+together in one node.
+*/
+type UnrolledLinkedList[T any] struct {
+	head         *unrolledNode[T]
+	tail         *unrolledNode[T]
+	count        int // The number of elements.
+	nodes        int // The number of nodes.
+	nodeCapacity int
+}
+
+/*
+Creates an empty list whose nodes hold up to nodeCapacity elements. Crashes
+if nodeCapacity is less than 2. The zero value is an empty list using
+DefaultNodeCapacity.
+*/
+func UnrolledLinkedListInit[T any](nodeCapacity int) *UnrolledLinkedList[T] {
+	if nodeCapacity < 2 {
+		panic("node capacity must be greater or equal to 2")
+	}
+	return &UnrolledLinkedList[T]{nodeCapacity: nodeCapacity}
+}
+
+func (self *UnrolledLinkedList[T]) IsEmpty() bool {
+	return self.count == 0
+}
+
+func (self *UnrolledLinkedList[T]) Count() int {
+	return self.count
+}
+
+/*
+Returns the number of nodes the elements are stored in.
+*/
+func (self *UnrolledLinkedList[T]) NodeCount() int {
+	return self.nodes
+}
+
+/*
+Returns the element at a specific index. Crashes if index is out of bounds (0...self.Count).
+Performance: O(n / nodeCapacity).
+*/
+func (self *UnrolledLinkedList[T]) At(index int) T {
+	node, offset := self.locate(index)
+	return node.elements[offset]
+}
+
+/*
+Replaces the element at a specific index. Crashes if index is out of bounds (0...self.Count).
+Performance: O(n / nodeCapacity).
+*/
+func (self *UnrolledLinkedList[T]) Set(index int, element T) {
+	node, offset := self.locate(index)
+	node.elements[offset] = element
+}
+
+/*
+Adds an element to the end of the list. Performance: O(1).
+*/
+func (self *UnrolledLinkedList[T]) Append(element T) {
+	if self.tail == nil || len(self.tail.elements) == self.capacity() {
+		// Appending to a fresh node keeps the full nodes behind it full.
+		self.link(self.newNode(), self.tail)
+	}
+	self.tail.elements = append(self.tail.elements, element)
+	self.count += 1
+}
+
+/*
+Inserts an element at a specific index. Crashes if index is out of bounds (0...self.Count).
+Performance: O(n / nodeCapacity + nodeCapacity).
+*/
+func (self *UnrolledLinkedList[T]) Insert(index int, element T) {
+	if index == self.count {
+		self.Append(element)
+		return
+	}
+	node, offset := self.locate(index)
+	if len(node.elements) == self.capacity() {
+		self.split(node)
+		if offset > len(node.elements) {
+			offset -= len(node.elements)
+			node = node.next
+		}
+	}
+	node.elements = append(node.elements, element)
+	copy(node.elements[offset+1:], node.elements[offset:])
+	node.elements[offset] = element
+	self.count += 1
+}
+
+/*
+Removes and returns the element at a specific index. Crashes if index is out of bounds (0...self.Count).
+Performance: O(n / nodeCapacity + nodeCapacity).
+*/
+func (self *UnrolledLinkedList[T]) RemoveAt(index int) T {
+	node, offset := self.locate(index)
+	element := node.elements[offset]
+	last := len(node.elements) - 1
+	copy(node.elements[offset:], node.elements[offset+1:])
+	var zero T
+	node.elements[last] = zero
+	node.elements = node.elements[:last]
+	self.count -= 1
+	self.rebalance(node)
+	return element
+}
+
+/*
+Removes every element.
+*/
+func (self *UnrolledLinkedList[T]) RemoveAll() {
+	self.head = nil
+	self.tail = nil
+	self.count = 0
+	self.nodes = 0
+}
+
+/*
+Calls body for every element in order, until body returns false.
+*/
+func (self *UnrolledLinkedList[T]) ForEach(body func(index int, element T) bool) {
+	index := 0
+	for node := self.head; node != nil; node = node.next {
+		for _, element := range node.elements {
+			if !body(index, element) {
+				return
+			}
+			index += 1
+		}
+	}
+}
+
+/*
+Returns the elements in order.
+*/
+func (self *UnrolledLinkedList[T]) Slice() []T {
+	elements := make([]T, 0, self.count)
+	for node := self.head; node != nil; node = node.next {
+		elements = append(elements, node.elements...)
+	}
+	return elements
+}
+
+func (self *UnrolledLinkedList[T]) capacity() int {
+	if self.nodeCapacity == 0 {
+		return DefaultNodeCapacity
+	}
+	return self.nodeCapacity
+}
+
+func (self *UnrolledLinkedList[T]) newNode() *unrolledNode[T] {
+	return &unrolledNode[T]{elements: make([]T, 0, self.capacity())}
+}
+
+/*
+Returns the node holding the element at index and its offset in that node,
+walking from whichever end of the list is closer.
+*/
+func (self *UnrolledLinkedList[T]) locate(index int) (*unrolledNode[T], int) {
+	if index < 0 || index >= self.count {
+		panic("index is out of bounds")
+	}
+	if index < self.count/2 {
+		node := self.head
+		for index >= len(node.elements) {
+			index -= len(node.elements)
+			node = node.next
+		}
+		return node, index
+	}
+	node := self.tail
+	index = self.count - 1 - index // The index counted from the back.
+	for index >= len(node.elements) {
+		index -= len(node.elements)
+		node = node.prev
+	}
+	return node, len(node.elements) - 1 - index
+}
+
+/*
+Links node into the list after prev, or at the front if prev is nil.
+*/
+func (self *UnrolledLinkedList[T]) link(node, prev *unrolledNode[T]) {
+	node.prev = prev
+	if prev == nil {
+		node.next = self.head
+		self.head = node
+	} else {
+		node.next = prev.next
+		prev.next = node
+	}
+	if node.next == nil {
+		self.tail = node
+	} else {
+		node.next.prev = node
+	}
+	self.nodes += 1
+}
+
+func (self *UnrolledLinkedList[T]) unlink(node *unrolledNode[T]) {
+	if node.prev == nil {
+		self.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		self.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev = nil
+	node.next = nil
+	self.nodes -= 1
+}
+
+/*
+Moves the second half of a full node into a new node that follows it.
+*/
+func (self *UnrolledLinkedList[T]) split(node *unrolledNode[T]) {
+	half := len(node.elements) / 2
+	next := self.newNode()
+	next.elements = append(next.elements, node.elements[half:]...)
+	zeroElements(node.elements[half:])
+	node.elements = node.elements[:half]
+	self.link(next, node)
+}
+
+/*
+Restores the fill threshold after an element was removed from node: a node
+less than half full merges with its successor if they fit in one node, and
+otherwise takes elements from it until it is half full. An empty node is unlinked.
+*/
+func (self *UnrolledLinkedList[T]) rebalance(node *unrolledNode[T]) {
+	threshold := self.capacity() / 2
+	if len(node.elements) >= threshold {
+		return
+	}
+	next := node.next
+	if next == nil {
+		// The last node, so merge into the previous one if possible.
+		if prev := node.prev; prev != nil && len(prev.elements)+len(node.elements) <= self.capacity() {
+			prev.elements = append(prev.elements, node.elements...)
+			self.unlink(node)
+		} else if len(node.elements) == 0 {
+			self.unlink(node)
+		}
+		return
+	}
+
+	if len(node.elements)+len(next.elements) <= self.capacity() {
+		node.elements = append(node.elements, next.elements...)
+		self.unlink(next)
+		return
+	}
+	moved := threshold - len(node.elements)
+	node.elements = append(node.elements, next.elements[:moved]...)
+	remaining := copy(next.elements, next.elements[moved:])
+	zeroElements(next.elements[remaining:])
+	next.elements = next.elements[:remaining]
+}
+
+/*
+Zeroes elements so the garbage collector can reclaim what they point to.
+*/
+func zeroElements[T any](elements []T) {
+	var zero T
+	for index := range elements {
+		elements[index] = zero
+	}
+}
@@ -0,0 +1,231 @@
+package unrolledlinkedlist
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/Jcowwell/go-algorithm-club/LinkedList"
+	. "github.com/Jcowwell/go-algorithm-club/Utils"
+)
+
+/*
+Checks the links, the counts and the fill threshold of every node.
+*/
+func assertWellFormed[T any](list *UnrolledLinkedList[T], t *testing.T) {
+	count, nodes := 0, 0
+	var prev *unrolledNode[T]
+	for node := list.head; node != nil; node = node.next {
+		AssertTrue(node.prev == prev, t)
+		AssertTrue(len(node.elements) > 0, t)
+		AssertTrue(len(node.elements) <= list.capacity(), t)
+		if node.next != nil {
+			AssertTrue(len(node.elements) >= list.capacity()/2, t)
+		}
+		count += len(node.elements)
+		nodes += 1
+		prev = node
+	}
+	AssertTrue(list.tail == prev, t)
+	AssertEqual(list.Count(), count, t)
+	AssertEqual(list.NodeCount(), nodes, t)
+}
+
+func TestEmptyList(t *testing.T) {
+	list := &UnrolledLinkedList[int]{}
+	AssertTrue(list.IsEmpty(), t)
+	AssertEqual(list.NodeCount(), 0, t)
+	AssertEqual(len(list.Slice()), 0, t)
+}
+
+func TestAppendFillsNodes(t *testing.T) {
+	list := UnrolledLinkedListInit[int](4)
+	for i := 0; i < 10; i++ {
+		list.Append(i)
+	}
+	AssertEqual(list.NodeCount(), 3, t)
+	AssertEqualSlice(list.Slice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, t)
+	for i := 0; i < 10; i++ {
+		AssertEqual(list.At(i), i, t)
+	}
+	assertWellFormed(list, t)
+}
+
+func TestInsertSplitsFullNode(t *testing.T) {
+	list := UnrolledLinkedListInit[int](4)
+	for _, element := range []int{0, 1, 3, 4} {
+		list.Append(element)
+	}
+	list.Insert(2, 2)
+	AssertEqual(list.NodeCount(), 2, t)
+	AssertEqualSlice(list.Slice(), []int{0, 1, 2, 3, 4}, t)
+	list.Insert(0, -1)
+	AssertEqualSlice(list.Slice(), []int{-1, 0, 1, 2, 3, 4}, t)
+	assertWellFormed(list, t)
+}
+
+func TestRemoveMergesNodes(t *testing.T) {
+	list := UnrolledLinkedListInit[int](4)
+	for i := 0; i < 8; i++ {
+		list.Append(i)
+	}
+	AssertEqual(list.NodeCount(), 2, t)
+	AssertEqual(list.RemoveAt(0), 0, t)
+	AssertEqual(list.RemoveAt(0), 1, t)
+	AssertEqual(list.RemoveAt(0), 2, t)
+	AssertEqual(list.NodeCount(), 2, t)
+	assertWellFormed(list, t)
+	// [3 4] [5 6 7]: the first node took an element from the second.
+	AssertEqual(list.RemoveAt(4), 7, t)
+	AssertEqual(list.RemoveAt(3), 6, t)
+	AssertEqual(list.NodeCount(), 1, t)
+	AssertEqualSlice(list.Slice(), []int{3, 4, 5}, t)
+	assertWellFormed(list, t)
+}
+
+func TestAgainstSlice(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, capacity := range []int{2, 3, 4, 16} {
+		list := UnrolledLinkedListInit[int](capacity)
+		var expected []int
+		for i := 0; i < 5000; i++ {
+			switch operation := random.Intn(5); {
+			case operation < 2 || len(expected) == 0:
+				index := random.Intn(len(expected) + 1)
+				list.Insert(index, i)
+				expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+			case operation == 2:
+				list.Append(i)
+				expected = append(expected, i)
+			case operation == 3:
+				index := random.Intn(len(expected))
+				AssertEqual(list.RemoveAt(index), expected[index], t)
+				expected = append(expected[:index], expected[index+1:]...)
+			default:
+				index := random.Intn(len(expected))
+				list.Set(index, -i)
+				expected[index] = -i
+				AssertEqual(list.At(index), -i, t)
+			}
+		}
+		AssertEqualSlice(list.Slice(), expected, t)
+		assertWellFormed(list, t)
+
+		for len(expected) > 0 {
+			index := random.Intn(len(expected))
+			AssertEqual(list.RemoveAt(index), expected[index], t)
+			expected = append(expected[:index], expected[index+1:]...)
+		}
+		AssertTrue(list.IsEmpty(), t)
+		assertWellFormed(list, t)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	list := UnrolledLinkedListInit[int](3)
+	for i := 0; i < 10; i++ {
+		list.Append(i * 10)
+	}
+	var visited []int
+	list.ForEach(func(index, element int) bool {
+		AssertEqual(element, index*10, t)
+		visited = append(visited, element)
+		return index < 4
+	})
+	AssertEqualSlice(visited, []int{0, 10, 20, 30, 40}, t)
+}
+
+func TestRemoveAll(t *testing.T) {
+	list := UnrolledLinkedListInit[int](3)
+	for i := 0; i < 10; i++ {
+		list.Append(i)
+	}
+	list.RemoveAll()
+	AssertTrue(list.IsEmpty(), t)
+	list.Append(1)
+	AssertEqualSlice(list.Slice(), []int{1}, t)
+	assertWellFormed(list, t)
+}
+
+func TestOutOfBoundsCrashes(t *testing.T) {
+	defer func() {
+		AssertTrue(recover() != nil, t)
+	}()
+	list := UnrolledLinkedListInit[int](4)
+	list.Append(1)
+	list.At(1)
+}
+
+const benchmarkSize = 10000
+
+func BenchmarkAppendUnrolledLinkedList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := &UnrolledLinkedList[int]{}
+		for j := 0; j < benchmarkSize; j++ {
+			list.Append(j)
+		}
+	}
+}
+
+func BenchmarkAppendLinkedList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := &LinkedList[int]{}
+		for j := 0; j < benchmarkSize; j++ {
+			list.AppendValue(j)
+		}
+	}
+}
+
+func BenchmarkIterateUnrolledLinkedList(b *testing.B) {
+	list := &UnrolledLinkedList[int]{}
+	for j := 0; j < benchmarkSize; j++ {
+		list.Append(j)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		list.ForEach(func(_ int, element int) bool {
+			sum += element
+			return true
+		})
+	}
+}
+
+func BenchmarkIterateLinkedList(b *testing.B) {
+	list := &LinkedList[int]{}
+	for j := 0; j < benchmarkSize; j++ {
+		list.AppendValue(j)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for cursor := list.Front(); cursor.Valid(); cursor.Next() {
+			sum += cursor.Value()
+		}
+	}
+}
+
+func BenchmarkRandomInsertUnrolledLinkedList(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := &UnrolledLinkedList[int]{}
+		for j := 0; j < benchmarkSize/10; j++ {
+			list.Insert(random.Intn(j+1), j)
+		}
+	}
+}
+
+func BenchmarkRandomInsertLinkedList(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := &LinkedList[int]{}
+		for j := 0; j < benchmarkSize/10; j++ {
+			list.InsertValue(j, random.Intn(j+1))
+		}
+	}
+}